@@ -0,0 +1,323 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+	accessTokenType        = "urn:ietf:params:oauth:token-type:access_token"
+
+	// tokenExpiryMargin renews access tokens before they expire, so requests in flight do not fail.
+	tokenExpiryMargin = time.Minute
+	// defaultTokenLifetime is assumed for access tokens returned without expires_in.
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+func clientCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Authenticate as a service account using the OAuth client credentials flow",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token_url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the OAuth token endpoint",
+				},
+				"client_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Client ID of the service account",
+				},
+				"client_secret": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "Client secret of the service account",
+				},
+				"scopes": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Scopes to request",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func oidcTokenExchangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Authenticate by exchanging an OIDC token issued by a CI system, such as GitLab or GitHub Actions, for an access token",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token_url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the OAuth token endpoint that performs the token exchange",
+				},
+				"client_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Client ID to present during the token exchange",
+				},
+				"audience": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Audience of the requested access token",
+				},
+				"token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of a file containing the OIDC token. The file is read on every exchange.",
+				},
+				"token_env": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "ACLOUD_OIDC_TOKEN",
+					Description: "Environment variable containing the OIDC token, used when token_file is not set",
+				},
+			},
+		},
+	}
+}
+
+// getAuthenticator returns the authenticator configured in the provider block. Only one authentication
+// method can be configured, including a personal access token set through an environment variable.
+func getAuthenticator(d *schema.ResourceData, settings providerSettings) (acloudapi.Authenticator, error) {
+	var configured []string
+	if settings.Token != "" {
+		configured = append(configured, "token")
+	}
+	if settings.TokenFile != "" {
+		configured = append(configured, "token_file")
+	}
+	for _, method := range []string{"client_credentials", "oidc_token_exchange"} {
+		if _, ok := d.GetOk(method); ok {
+			configured = append(configured, method)
+		}
+	}
+	if len(configured) > 1 {
+		return nil, fmt.Errorf("only one authentication method can be configured, got %s. Methods can also be set through ACLOUD_PERSONAL_ACCESS_TOKEN or ACLOUD_TOKEN_FILE", strings.Join(configured, ", "))
+	}
+
+	if settings.TokenFile != "" {
+		return newTokenFileAuthenticator(settings.TokenFile), nil
+	}
+	if v, ok := d.GetOk("client_credentials"); ok {
+		config := v.([]interface{})[0].(map[string]interface{})
+		scopes := make([]string, 0)
+		for _, scope := range config["scopes"].([]interface{}) {
+			scopes = append(scopes, scope.(string))
+		}
+		return newClientCredentialsAuthenticator(config["token_url"].(string), config["client_id"].(string), config["client_secret"].(string), scopes), nil
+	}
+	if v, ok := d.GetOk("oidc_token_exchange"); ok {
+		config := v.([]interface{})[0].(map[string]interface{})
+		return newOIDCTokenExchangeAuthenticator(config["token_url"].(string), config["client_id"].(string), config["audience"].(string), config["token_file"].(string), config["token_env"].(string)), nil
+	}
+
+	if settings.Token == "" {
+		return nil, fmt.Errorf("no credentials configured, set token, token_file, client_credentials or oidc_token_exchange")
+	}
+	return acloudapi.NewPersonalAccessTokenAuthenticator(settings.Token), nil
+}
+
+// tokenFileAuthenticator authenticates with a token read from a file. The file is read again when it
+// changes, so tokens rotated by an external process are picked up without reconfiguring the provider.
+type tokenFileAuthenticator struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+func newTokenFileAuthenticator(path string) *tokenFileAuthenticator {
+	return &tokenFileAuthenticator{path: path}
+}
+
+func (a *tokenFileAuthenticator) Authenticate(client *resty.Client, request *resty.Request) error {
+	token, err := a.getToken()
+	if err != nil {
+		return err
+	}
+	request.SetAuthToken(token)
+	return nil
+}
+
+func (a *tokenFileAuthenticator) getToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	if a.token != "" && info.ModTime().Equal(a.modTime) {
+		return a.token, nil
+	}
+
+	token, err := readToken(a.path)
+	if err != nil {
+		return "", err
+	}
+	a.token = token
+	a.modTime = info.ModTime()
+	return a.token, nil
+}
+
+func readToken(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return token, nil
+}
+
+// oauthAuthenticator authenticates with an access token obtained from an OAuth token endpoint,
+// requesting a new access token shortly before the current one expires.
+type oauthAuthenticator struct {
+	tokenURL string
+	formData func() (map[string]string, error)
+	client   *resty.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func newClientCredentialsAuthenticator(tokenURL, clientID, clientSecret string, scopes []string) *oauthAuthenticator {
+	return newOAuthAuthenticator(tokenURL, func() (map[string]string, error) {
+		formData := map[string]string{
+			"grant_type":    "client_credentials",
+			"client_id":     clientID,
+			"client_secret": clientSecret,
+		}
+		if len(scopes) > 0 {
+			formData["scope"] = strings.Join(scopes, " ")
+		}
+		return formData, nil
+	})
+}
+
+func newOIDCTokenExchangeAuthenticator(tokenURL, clientID, audience, tokenFile, tokenEnv string) *oauthAuthenticator {
+	return newOAuthAuthenticator(tokenURL, func() (map[string]string, error) {
+		// CI systems issue short-lived OIDC tokens, so the token is read again on every exchange
+		var subjectToken string
+		if tokenFile != "" {
+			token, err := readToken(tokenFile)
+			if err != nil {
+				return nil, err
+			}
+			subjectToken = token
+		} else {
+			subjectToken = strings.TrimSpace(os.Getenv(tokenEnv))
+			if subjectToken == "" {
+				return nil, fmt.Errorf("environment variable %s does not contain an OIDC token", tokenEnv)
+			}
+		}
+
+		formData := map[string]string{
+			"grant_type":           tokenExchangeGrantType,
+			"subject_token":        subjectToken,
+			"subject_token_type":   jwtTokenType,
+			"requested_token_type": accessTokenType,
+		}
+		if clientID != "" {
+			formData["client_id"] = clientID
+		}
+		if audience != "" {
+			formData["audience"] = audience
+		}
+		return formData, nil
+	})
+}
+
+func newOAuthAuthenticator(tokenURL string, formData func() (map[string]string, error)) *oauthAuthenticator {
+	return &oauthAuthenticator{
+		tokenURL: tokenURL,
+		formData: formData,
+		client:   resty.New().SetTimeout(30 * time.Second),
+	}
+}
+
+func (a *oauthAuthenticator) Authenticate(client *resty.Client, request *resty.Request) error {
+	token, err := a.getToken(request.Context())
+	if err != nil {
+		return err
+	}
+	request.SetAuthToken(token)
+	return nil
+}
+
+func (a *oauthAuthenticator) getToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Before(a.expiry.Add(-tokenExpiryMargin)) {
+		return a.token, nil
+	}
+
+	formData, err := a.formData()
+	if err != nil {
+		return "", err
+	}
+	var result oauthTokenResponse
+	response, err := a.client.R().
+		SetContext(ctx).
+		SetFormData(formData).
+		SetResult(&result).
+		SetError(&result).
+		Post(a.tokenURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token from %s: %w", a.tokenURL, err)
+	}
+	if response.IsError() {
+		if result.Error != "" {
+			return "", fmt.Errorf("failed to request access token from %s: %s: %s", a.tokenURL, result.Error, result.ErrorDescription)
+		}
+		return "", fmt.Errorf("failed to request access token from %s: %s", a.tokenURL, response.Status())
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint %s did not return an access token", a.tokenURL)
+	}
+
+	lifetime := time.Duration(result.ExpiresIn) * time.Second
+	if lifetime == 0 {
+		lifetime = defaultTokenLifetime
+	}
+	a.token = result.AccessToken
+	a.expiry = time.Now().Add(lifetime)
+	return a.token, nil
+}
@@ -0,0 +1,354 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetAuthenticator(t *testing.T) {
+	clientCredentials := []interface{}{map[string]interface{}{
+		"token_url":     "https://auth.example/token",
+		"client_id":     "client",
+		"client_secret": "secret",
+	}}
+	oidcTokenExchange := []interface{}{map[string]interface{}{
+		"token_url": "https://auth.example/token",
+	}}
+
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		settings providerSettings
+		wantType string
+		wantErr  string
+	}{
+		{
+			name:     "token",
+			settings: providerSettings{Token: "token"},
+			wantType: "personal access token",
+		},
+		{
+			name:     "token file",
+			settings: providerSettings{TokenFile: "/tokens/acloud"},
+			wantType: "token file",
+		},
+		{
+			name:     "client credentials",
+			raw:      map[string]interface{}{"client_credentials": clientCredentials},
+			wantType: "oauth",
+		},
+		{
+			name:     "oidc token exchange",
+			raw:      map[string]interface{}{"oidc_token_exchange": oidcTokenExchange},
+			wantType: "oauth",
+		},
+		{
+			name:    "no credentials",
+			wantErr: "no credentials configured",
+		},
+		{
+			name:     "token with token file",
+			settings: providerSettings{Token: "token", TokenFile: "/tokens/acloud"},
+			wantErr:  "got token, token_file",
+		},
+		{
+			name:     "token from environment with client credentials",
+			raw:      map[string]interface{}{"client_credentials": clientCredentials},
+			settings: providerSettings{Token: "token"},
+			wantErr:  "got token, client_credentials",
+		},
+		{
+			name:     "token file with oidc token exchange",
+			raw:      map[string]interface{}{"oidc_token_exchange": oidcTokenExchange},
+			settings: providerSettings{TokenFile: "/tokens/acloud"},
+			wantErr:  "got token_file, oidc_token_exchange",
+		},
+		{
+			name:    "client credentials with oidc token exchange",
+			raw:     map[string]interface{}{"client_credentials": clientCredentials, "oidc_token_exchange": oidcTokenExchange},
+			wantErr: "got client_credentials, oidc_token_exchange",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+			authenticator, err := getAuthenticator(d, tt.settings)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("getAuthenticator() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getAuthenticator() error = %v", err)
+			}
+
+			got := "personal access token"
+			switch authenticator.(type) {
+			case *tokenFileAuthenticator:
+				got = "token file"
+			case *oauthAuthenticator:
+				got = "oauth"
+			}
+			if got != tt.wantType {
+				t.Errorf("getAuthenticator() = %s authenticator, want %s", got, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestTokenFileAuthenticator(t *testing.T) {
+	modTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// update changes the token file after the first token has been read
+		update  func(t *testing.T, path string)
+		first   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "unchanged",
+			first: "token-1",
+			want:  "token-1",
+		},
+		{
+			name:  "rotated",
+			first: "token-1",
+			update: func(t *testing.T, path string) {
+				writeTokenFile(t, path, "token-2\n", modTime.Add(time.Minute))
+			},
+			want: "token-2",
+		},
+		{
+			name:  "content changed without modification time",
+			first: "token-1",
+			update: func(t *testing.T, path string) {
+				writeTokenFile(t, path, "token-2", modTime)
+			},
+			want: "token-1",
+		},
+		{
+			name:  "emptied",
+			first: "token-1",
+			update: func(t *testing.T, path string) {
+				writeTokenFile(t, path, "  \n", modTime.Add(time.Minute))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "removed",
+			first: "token-1",
+			update: func(t *testing.T, path string) {
+				if err := os.Remove(path); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "token")
+			writeTokenFile(t, path, tt.first, modTime)
+
+			authenticator := newTokenFileAuthenticator(path)
+			got, err := authenticator.getToken()
+			if err != nil {
+				t.Fatalf("getToken() error = %v", err)
+			}
+			if got != tt.first {
+				t.Fatalf("getToken() = %q, want %q", got, tt.first)
+			}
+
+			if tt.update != nil {
+				tt.update(t, path)
+			}
+			got, err = authenticator.getToken()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getToken() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func writeTokenFile(t *testing.T, path, token string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOAuthAuthenticator(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantRequests int32
+		wantToken    string
+		wantErr      string
+	}{
+		{
+			name:         "token reused until expiry",
+			status:       http.StatusOK,
+			body:         `{"access_token": "token-%d", "expires_in": 3600}`,
+			wantRequests: 1,
+			wantToken:    "token-1",
+		},
+		{
+			name:         "token renewed within the expiry margin",
+			status:       http.StatusOK,
+			body:         `{"access_token": "token-%d", "expires_in": 59}`,
+			wantRequests: 2,
+			wantToken:    "token-2",
+		},
+		{
+			name:         "token without expiry uses the default lifetime",
+			status:       http.StatusOK,
+			body:         `{"access_token": "token-%d"}`,
+			wantRequests: 1,
+			wantToken:    "token-1",
+		},
+		{
+			name:         "oauth error",
+			status:       http.StatusUnauthorized,
+			body:         `{"error": "invalid_client", "error_description": "unknown client"}`,
+			wantRequests: 1,
+			wantErr:      "invalid_client: unknown client",
+		},
+		{
+			name:         "error without body",
+			status:       http.StatusInternalServerError,
+			wantRequests: 1,
+			wantErr:      "500",
+		},
+		{
+			name:         "missing access token",
+			status:       http.StatusOK,
+			body:         `{"expires_in": 3600}`,
+			wantRequests: 1,
+			wantErr:      "did not return an access token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := requests.Add(1)
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("failed to parse form: %v", err)
+				}
+				if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_secret") != "secret" || r.PostForm.Get("scope") != "read write" {
+					t.Errorf("unexpected form %v", r.PostForm)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				if strings.Contains(tt.body, "%d") {
+					fmt.Fprintf(w, tt.body, n)
+				} else {
+					fmt.Fprint(w, tt.body)
+				}
+			}))
+			defer server.Close()
+
+			authenticator := newClientCredentialsAuthenticator(server.URL, "client", "secret", []string{"read", "write"})
+			var got string
+			var err error
+			for i := 0; i < 2 && err == nil; i++ {
+				got, err = authenticator.getToken(context.Background())
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("getToken() error = %v, want error containing %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("getToken() error = %v", err)
+			}
+			if got != tt.wantToken {
+				t.Errorf("getToken() = %q, want %q", got, tt.wantToken)
+			}
+			if requests.Load() != tt.wantRequests {
+				t.Errorf("token endpoint received %d requests, want %d", requests.Load(), tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestOIDCTokenExchangeAuthenticator(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "oidc-token")
+	if err := os.WriteFile(tokenFile, []byte("file-jwt\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		tokenFile string
+		tokenEnv  string
+		envValue  string
+		wantJWT   string
+		wantErr   string
+	}{
+		{name: "token from environment", tokenEnv: "ACLOUD_TEST_OIDC_TOKEN", envValue: "env-jwt", wantJWT: "env-jwt"},
+		{name: "token file takes precedence", tokenFile: tokenFile, tokenEnv: "ACLOUD_TEST_OIDC_TOKEN", envValue: "env-jwt", wantJWT: "file-jwt"},
+		{name: "empty environment variable", tokenEnv: "ACLOUD_TEST_OIDC_TOKEN", wantErr: "does not contain an OIDC token"},
+		{name: "missing token file", tokenFile: filepath.Join(t.TempDir(), "missing"), wantErr: "failed to read token file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ACLOUD_TEST_OIDC_TOKEN", tt.envValue)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("failed to parse form: %v", err)
+				}
+				if got := r.PostForm.Get("subject_token"); got != tt.wantJWT {
+					t.Errorf("subject_token = %q, want %q", got, tt.wantJWT)
+				}
+				if got := r.PostForm.Get("grant_type"); got != tokenExchangeGrantType {
+					t.Errorf("grant_type = %q, want %q", got, tokenExchangeGrantType)
+				}
+				if got := r.PostForm.Get("audience"); got != "acloud" {
+					t.Errorf("audience = %q, want %q", got, "acloud")
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"access_token": "access-token", "expires_in": 300}`)
+			}))
+			defer server.Close()
+
+			authenticator := newOIDCTokenExchangeAuthenticator(server.URL, "", "acloud", tt.tokenFile, tt.tokenEnv)
+			got, err := authenticator.getToken(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("getToken() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getToken() error = %v", err)
+			}
+			if got != "access-token" {
+				t.Errorf("getToken() = %q, want %q", got, "access-token")
+			}
+		})
+	}
+}
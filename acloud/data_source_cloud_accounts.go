@@ -2,9 +2,12 @@ package acloud
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 )
@@ -33,23 +36,95 @@ func dataSourceCloudAccounts() *schema.Resource {
 				Description: "Cloud Account Name",
 				Optional:    true,
 			},
+			"display_name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression the name of the Cloud Account must match",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Only return enabled (`true`) or disabled (`false`) Cloud Accounts. Returns both when not set.",
+				Optional:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only return Cloud Accounts that can deploy in this region",
+				Optional:    true,
+			},
 			"cloud_accounts": {
 				Type:        schema.TypeList,
 				Description: "List of Cloud Accounts",
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"identity": {
+							Type:        schema.TypeString,
+							Description: "Identity of the Cloud Account",
+							Computed:    true,
+						},
 						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Description: "Name of the Cloud Account",
+							Computed:    true,
 						},
 						"slug": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:       schema.TypeString,
+							Deprecated: "Cloud Accounts do not have a slug, use identity instead",
+							Computed:   true,
 						},
 						"available": {
-							Type:     schema.TypeBool,
-							Computed: true,
+							Type:       schema.TypeBool,
+							Deprecated: "replaced by enabled",
+							Computed:   true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Returns if the Cloud Account is enabled",
+							Computed:    true,
+						},
+						"cloud_provider": {
+							Type:        schema.TypeString,
+							Description: "Slug of the Cloud Provider of the Cloud Account",
+							Computed:    true,
+						},
+						"cloud_profile_identity": {
+							Type:        schema.TypeString,
+							Description: "Identity of the cloud profile",
+							Computed:    true,
+						},
+						"cloud_profile_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the cloud profile",
+							Computed:    true,
+						},
+						"regions": {
+							Type:        schema.TypeList,
+							Description: "Regions of the Cloud Account",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"primary_cloud_credentials_identity": {
+							Type:        schema.TypeString,
+							Description: "Identity of the primary cloud credentials",
+							Computed:    true,
+						},
+						"vsphere_parent_folder": {
+							Type:        schema.TypeString,
+							Description: "vSphere parent folder",
+							Computed:    true,
+						},
+						"vsphere_parent_resource_pool": {
+							Type:        schema.TypeString,
+							Description: "vSphere parent resource pool",
+							Computed:    true,
+						},
+						"openstack_tenant_id": {
+							Type:        schema.TypeString,
+							Description: "OpenStack tenant ID",
+							Computed:    true,
 						},
 					},
 				},
@@ -68,23 +143,32 @@ func dataSourceCloudAccountsRead(ctx context.Context, d *schema.ResourceData, m
 
 	providerFilter := d.Get("cloud_provider").(string)
 	accountNameFilter := d.Get("cloud_account_name").(string)
+	regionFilter := d.Get("region").(string)
+	enabledFilter := getOptionalBoolFilter(d, "enabled")
+	displayNameFilter, err := getNameRegexFilter(d, "display_name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	cloudAccounts, err := client.GetCloudAccounts(ctx, org)
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("failed to get cloud accounts for organisation %q: %w", org, err))
 	}
 
 	d.SetId(org)
 
-	accounts := make([]map[string]interface{}, len(cloudAccounts))
-	for i, account := range cloudAccounts {
-		if providerFilter != "" && account.CloudProfile.CloudProvider != providerFilter {
+	accounts := make([]map[string]interface{}, 0, len(cloudAccounts))
+	for _, account := range cloudAccounts {
+		if !matchesStringFilter(providerFilter, account.CloudProfile.CloudProvider) ||
+			!matchesStringFilter(accountNameFilter, account.DisplayName) ||
+			!matchesNameRegex(displayNameFilter, account.DisplayName) ||
+			!matchesBoolFilter(enabledFilter, account.Enabled) {
 			continue
 		}
-		if accountNameFilter != "" && account.DisplayName != accountNameFilter {
+		if regionFilter != "" && !slices.Contains(account.CloudProfile.Regions, regionFilter) {
 			continue
 		}
-		accounts[i] = getCloudAccountAttributes(account)
+		accounts = append(accounts, getCloudAccountAttributes(account))
 	}
 	d.Set("cloud_accounts", accounts)
 	return nil
@@ -92,8 +176,17 @@ func dataSourceCloudAccountsRead(ctx context.Context, d *schema.ResourceData, m
 
 func getCloudAccountAttributes(cloudAccount acloudapi.CloudAccount) map[string]interface{} {
 	return map[string]interface{}{
-		"name":     cloudAccount.DisplayName,
-		"identity": cloudAccount.Identity,
-		"enabled":  cloudAccount.Enabled,
+		"identity":                           cloudAccount.Identity,
+		"name":                               cloudAccount.DisplayName,
+		"enabled":                            cloudAccount.Enabled,
+		"available":                          cloudAccount.Enabled,
+		"cloud_provider":                     cloudAccount.CloudProfile.CloudProvider,
+		"cloud_profile_identity":             cloudAccount.CloudProfile.Identity,
+		"cloud_profile_name":                 cloudAccount.CloudProfile.DisplayName,
+		"regions":                            cloudAccount.CloudProfile.Regions,
+		"primary_cloud_credentials_identity": cloudAccount.PrimaryCloudCredentialsIdentity,
+		"vsphere_parent_folder":              stringOrEmpty(cloudAccount.Metadata.VsphereParentFolder),
+		"vsphere_parent_resource_pool":       stringOrEmpty(cloudAccount.Metadata.VSphereParentResourcePool),
+		"openstack_tenant_id":                stringOrEmpty(cloudAccount.Metadata.OpenStackTenantID),
 	}
 }
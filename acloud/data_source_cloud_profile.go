@@ -20,14 +20,19 @@ func dataSourceCloudProfile() *schema.Resource {
 				Computed: true,
 			},
 			"identity": {
-				Type:        schema.TypeString,
-				Description: "Identity of the Cloud Profile",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "Identity of the Cloud Profile",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identity", "name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Description: "Name of the Cloud Account",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "Name of the Cloud Profile. Requires cloud_provider to be set.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identity", "name"},
+				RequiredWith: []string{"cloud_provider"},
 			},
 			"public": {
 				Type:        schema.TypeBool,
@@ -36,12 +41,13 @@ func dataSourceCloudProfile() *schema.Resource {
 			},
 			"cloud_provider": {
 				Type:        schema.TypeString,
-				Description: "Slug of the Cloud Provider of the Cloud Account",
+				Description: "Slug of the Cloud Provider of the Cloud Profile",
 				Optional:    true,
+				Computed:    true,
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
-				Description: "Returns if the Cloud Account is enabled",
+				Description: "Returns if the Cloud Profile is enabled",
 				Computed:    true,
 			},
 			"type": {
@@ -76,20 +82,9 @@ func dataCloudProfileRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(fmt.Errorf("failed to get cloud profiles for organisation %q: %w", org, err))
 	}
 
-	var cloudProfile acloudapi.CloudProfile
-
-	for _, profile := range cloudProfiles {
-		if displayName != "" && profile.CloudProvider == cloudProvider && strings.EqualFold(profile.DisplayName, displayName) {
-			cloudProfile = profile
-			break
-		}
-		if profile.Identity == d.Get("identity").(string) {
-			cloudProfile = profile
-			break
-		}
-	}
-	if cloudProfile.Identity == "" {
-		return diag.FromErr(fmt.Errorf("cloud profile %q not found", displayName))
+	cloudProfile, err := findCloudProfile(cloudProfiles, d.Get("identity").(string), displayName, cloudProvider)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(cloudProfile.Identity)
@@ -102,3 +97,34 @@ func dataCloudProfileRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("regions", cloudProfile.Regions)
 	return nil
 }
+
+// findCloudProfile looks up a cloud profile by identity, or by case-insensitive name within a cloud provider.
+func findCloudProfile(cloudProfiles []acloudapi.CloudProfile, identity, name, cloudProvider string) (*acloudapi.CloudProfile, error) {
+	if identity != "" {
+		for _, profile := range cloudProfiles {
+			if profile.Identity == identity {
+				return &profile, nil
+			}
+		}
+		return nil, fmt.Errorf("cloud profile with identity %q not found", identity)
+	}
+
+	var matches []acloudapi.CloudProfile
+	for _, profile := range cloudProfiles {
+		if profile.CloudProvider == cloudProvider && strings.EqualFold(profile.DisplayName, name) {
+			matches = append(matches, profile)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("cloud profile %q not found for cloud provider %q", name, cloudProvider)
+	case 1:
+		return &matches[0], nil
+	default:
+		identities := make([]string, len(matches))
+		for i, profile := range matches {
+			identities[i] = profile.Identity
+		}
+		return nil, fmt.Errorf("found %d cloud profiles named %q for cloud provider %q (%s), use identity instead", len(matches), name, cloudProvider, strings.Join(identities, ", "))
+	}
+}
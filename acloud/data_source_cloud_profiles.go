@@ -0,0 +1,121 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceCloudProfiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all cloud profiles available to an organisation",
+		ReadContext: dataSourceCloudProfilesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Description: "Slug of the Organisation",
+				Optional:    true,
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Description: "Only return Cloud Profiles of this Cloud Provider",
+				Optional:    true,
+			},
+			"cloud_profiles": {
+				Type:        schema.TypeList,
+				Description: "List of Cloud Profiles",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity": {
+							Type:        schema.TypeString,
+							Description: "Identity of the Cloud Profile",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the Cloud Profile",
+							Computed:    true,
+						},
+						"cloud_provider": {
+							Type:        schema.TypeString,
+							Description: "Slug of the Cloud Provider of the Cloud Profile",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the Cloud Profile",
+							Computed:    true,
+						},
+						"public": {
+							Type:        schema.TypeBool,
+							Description: "Returns if the Cloud Profile is publicly available",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Returns if the Cloud Profile is enabled",
+							Computed:    true,
+						},
+						"regions": {
+							Type:        schema.TypeList,
+							Description: "Regions available for the Cloud Profile",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProfilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudProviderFilter := d.Get("cloud_provider").(string)
+
+	cloudProfiles, err := client.GetCloudProfiles(ctx, org)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get cloud profiles for organisation %q: %w", org, err))
+	}
+
+	setAsID(d, fmt.Sprintf("%s-%s", org, cloudProviderFilter))
+
+	profiles := make([]map[string]interface{}, 0, len(cloudProfiles))
+	for _, cloudProfile := range cloudProfiles {
+		if !matchesStringFilter(cloudProviderFilter, cloudProfile.CloudProvider) {
+			continue
+		}
+		profiles = append(profiles, getCloudProfileAttributes(cloudProfile))
+	}
+	d.Set("cloud_profiles", profiles)
+	return nil
+}
+
+func getCloudProfileAttributes(cloudProfile acloudapi.CloudProfile) map[string]interface{} {
+	return map[string]interface{}{
+		"identity":       cloudProfile.Identity,
+		"name":           cloudProfile.DisplayName,
+		"cloud_provider": cloudProfile.CloudProvider,
+		"type":           cloudProfile.Type,
+		"public":         cloudProfile.Public,
+		"enabled":        cloudProfile.Enabled,
+		"regions":        cloudProfile.Regions,
+	}
+}
@@ -1,17 +1,20 @@
 package acloud
 
 import (
+	"cmp"
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 )
 
 func dataSourceCloudProviderNodeTypes() *schema.Resource {
 	return &schema.Resource{
-		Description: "List all Node types available on the given cloud provider",
+		Description: "List all Node types available on the given cloud provider, optionally filtered by requirements",
 		ReadContext: dataSourceCloudProviderNodeTypesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -22,6 +25,51 @@ func dataSourceCloudProviderNodeTypes() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"min_cpu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum CPU count of the node type",
+			},
+			"min_memory": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum memory in MB of the node type",
+			},
+			"gpu": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return node types with (`true`) or without (`false`) a GPU. Returns both when not set.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return node types available in this region",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return node types available in this availability zone",
+			},
+			"sort_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"type", "cpu", "memory"}, false),
+				Description:  "Sort the node types by `type`, `cpu` or `memory`. Node types are returned in API order when not set.",
+			},
+			"sort_order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "asc",
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+				Description:  "Sort order of the node types, either `asc` or `desc`",
+			},
+			"best_match": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Smallest node type, by CPU and then memory, that meets all requirements. Empty when no node type matches.",
+			},
 			"node_types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -42,6 +90,11 @@ func dataSourceCloudProviderNodeTypes() *schema.Resource {
 							Description: "Memory in MB",
 							Computed:    true,
 						},
+						"gpu": {
+							Type:        schema.TypeBool,
+							Description: "Has a GPU",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -54,6 +107,11 @@ func dataSourceCloudProviderNodeTypesRead(ctx context.Context, d *schema.Resourc
 	client := provider.Client
 
 	cloudProviderSlug := d.Get("cloud_provider").(string)
+	minCPU := d.Get("min_cpu").(int)
+	minMemory := d.Get("min_memory").(int)
+	gpuFilter := getOptionalBoolFilter(d, "gpu")
+	regionFilter := d.Get("region").(string)
+	availabilityZoneFilter := d.Get("availability_zone").(string)
 
 	nodeTypes, err := client.GetNodeTypes(ctx, cloudProviderSlug)
 	if err != nil {
@@ -62,18 +120,73 @@ func dataSourceCloudProviderNodeTypesRead(ctx context.Context, d *schema.Resourc
 
 	d.SetId(cloudProviderSlug)
 
-	providersState := make([]map[string]interface{}, len(nodeTypes))
-	for i, nodeType := range nodeTypes {
+	matching := make([]acloudapi.NodeType, 0, len(nodeTypes))
+	for _, nodeType := range nodeTypes {
+		if nodeType.CPU < minCPU || nodeType.Memory < minMemory || !matchesBoolFilter(gpuFilter, nodeType.GPU) {
+			continue
+		}
+		if regionFilter != "" && !slices.Contains(nodeType.Regions, regionFilter) {
+			continue
+		}
+		if availabilityZoneFilter != "" && !slices.Contains(nodeType.AvailabilityZones, availabilityZoneFilter) {
+			continue
+		}
+		matching = append(matching, nodeType)
+	}
+
+	bestMatch := ""
+	if len(matching) > 0 {
+		bestMatch = slices.MinFunc(matching, compareNodeTypeSize).Type
+	}
+
+	sortNodeTypes(matching, d.Get("sort_by").(string), d.Get("sort_order").(string) == "desc")
+
+	providersState := make([]map[string]interface{}, len(matching))
+	for i, nodeType := range matching {
 		providersState[i] = getNodeTypeAttributes(nodeType)
 	}
 	d.Set("node_types", providersState)
+	d.Set("best_match", bestMatch)
 	return nil
 }
 
+// compareNodeTypeSize orders node types by CPU count, then memory, then type name.
+func compareNodeTypeSize(a, b acloudapi.NodeType) int {
+	return cmp.Or(
+		cmp.Compare(a.CPU, b.CPU),
+		cmp.Compare(a.Memory, b.Memory),
+		cmp.Compare(a.Type, b.Type),
+	)
+}
+
+func sortNodeTypes(nodeTypes []acloudapi.NodeType, sortBy string, descending bool) {
+	var compare func(a, b acloudapi.NodeType) int
+	switch sortBy {
+	case "type":
+		compare = func(a, b acloudapi.NodeType) int { return cmp.Compare(a.Type, b.Type) }
+	case "cpu":
+		compare = compareNodeTypeSize
+	case "memory":
+		compare = func(a, b acloudapi.NodeType) int {
+			return cmp.Or(cmp.Compare(a.Memory, b.Memory), compareNodeTypeSize(a, b))
+		}
+	default:
+		return
+	}
+
+	slices.SortStableFunc(nodeTypes, func(a, b acloudapi.NodeType) int {
+		if descending {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+}
+
 func getNodeTypeAttributes(nodeType acloudapi.NodeType) map[string]interface{} {
 	return map[string]interface{}{
 		"type":   nodeType.Type,
 		"cpu":    nodeType.CPU,
 		"memory": nodeType.Memory,
+		"gpu":    nodeType.GPU,
 	}
 }
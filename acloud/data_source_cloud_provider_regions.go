@@ -27,6 +27,11 @@ func dataSourceCloudProviderRegions() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"include_availability_zones": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Look up the availability zones of every available region. Requires an additional API call per region. Defaults to false.",
+			},
 			"regions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -47,6 +52,24 @@ func dataSourceCloudProviderRegions() *schema.Resource {
 							Description: "Is the region available for use",
 							Computed:    true,
 						},
+						"availability_zones": {
+							Type:        schema.TypeList,
+							Description: "Slugs of the availability zones of the region",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"supports_multi_availability_zones": {
+							Type:        schema.TypeBool,
+							Description: "Can clusters in this region spread their node pools over multiple availability zones. Requires at least two available zones, and is always false when include_availability_zones is disabled.",
+							Computed:    true,
+						},
+						"supports_private_cluster": {
+							Type:        schema.TypeBool,
+							Description: "Can clusters in this region be deployed with a NAT gateway",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -71,18 +94,38 @@ func dataSourceCloudProviderRegionsRead(ctx context.Context, d *schema.ResourceD
 
 	setAsID(d, fmt.Sprintf("%s-%s", org, cloudProviderSlug))
 
+	includeAvailabilityZones := d.Get("include_availability_zones").(bool)
+
 	providersState := make([]map[string]interface{}, len(regions))
 	for i, region := range regions {
-		providersState[i] = getRegionAttributes(region)
+		availabilityZones := []acloudapi.AvailabilityZone{}
+		if includeAvailabilityZones && region.Available {
+			availabilityZones, err = client.GetAvailabilityZones(ctx, org, cloudProviderSlug, region.Slug)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to get availability zones for region %q: %w", region.Slug, err))
+			}
+		}
+		providersState[i] = getRegionAttributes(region, availabilityZones)
 	}
 	d.Set("regions", providersState)
 	return nil
 }
 
-func getRegionAttributes(region acloudapi.Region) map[string]interface{} {
+func getRegionAttributes(region acloudapi.Region, availabilityZones []acloudapi.AvailabilityZone) map[string]interface{} {
+	zones := make([]string, len(availabilityZones))
+	availableZones := 0
+	for i, az := range availabilityZones {
+		zones[i] = az.Slug
+		if az.Available {
+			availableZones++
+		}
+	}
 	return map[string]interface{}{
-		"name":      region.Name,
-		"slug":      region.Slug,
-		"available": region.Available,
+		"name":                              region.Name,
+		"slug":                              region.Slug,
+		"available":                         region.Available,
+		"availability_zones":                zones,
+		"supports_multi_availability_zones": availableZones > 1,
+		"supports_private_cluster":          region.SupportsNATGateway,
 	}
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 )
 
 func dataSourceCluster() *schema.Resource {
@@ -17,10 +19,17 @@ func dataSourceCluster() *schema.Resource {
 				Computed:    true,
 				Description: "The Cluster UUID Identity as the ID of this Terraform resource",
 			},
+			"identity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identity", "slug"},
+				Description:  "UUID Identity of the cluster. Can be used instead of environment and slug to look up the cluster.",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The internal Terraform identifier.",
+				Description: "Name of the Cluster",
 			},
 			"organisation": {
 				Type:        schema.TypeString,
@@ -28,9 +37,11 @@ func dataSourceCluster() *schema.Resource {
 				Description: "Slug of the organisation of the cluster",
 			},
 			"environment": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Slug of the environment that the cluster is part of",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"slug"},
+				Description:  "Slug of the environment that the cluster is part of",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -38,9 +49,12 @@ func dataSourceCluster() *schema.Resource {
 				Description: "Description of the Cluster",
 			},
 			"slug": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Slug of the cluster",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identity", "slug"},
+				RequiredWith: []string{"environment"},
+				Description:  "Slug of the cluster",
 			},
 			"cni": {
 				Type:        schema.TypeString,
@@ -89,14 +103,40 @@ func dataSourceCluster() *schema.Resource {
 			},
 			"enable_multi_availability_zones": {
 				Type:        schema.TypeBool,
-				Optional:    true,
+				Computed:    true,
 				Description: "Used to configure if the cluster should support multi availability zones for its node pools",
 			},
+			"enable_high_available_control_plane": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is Highly-Availability mode enabled for the cluster's Kubernetes Control Plane",
+			},
+			"enable_private_cluster": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the NAT gateway enabled for the cluster",
+			},
+			"enable_network_encryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is Network Encryption enabled at the node level",
+			},
+			"enable_auto_upgrade": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is auto-upgrade enabled for the cluster",
+			},
 			"maintenance_schedule_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "UUID Identity of the maintenance schedule for the cluster",
 			},
+			"next_maintenance_windows": nextMaintenanceWindowsSchema(),
+			"maintenance_freeze_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is a maintenance freeze currently active for the cluster",
+			},
 			"addons": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -121,6 +161,12 @@ func dataSourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"node_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Node Pools of the cluster",
+				Elem:        nodePoolAttributesResource(),
+			},
 		},
 	}
 }
@@ -135,34 +181,78 @@ func dataClusterRead(ctx context.Context, d *schema.ResourceData, m interface{})
 
 	env := d.Get("environment").(string)
 	slug := d.Get("slug").(string)
+	identity := d.Get("identity").(string)
 
-	cluster, err := client.GetCluster(ctx, org, env, slug)
+	var cluster *acloudapi.Cluster
+	if identity != "" {
+		cluster, err = getClusterByIdentity(ctx, client, org, identity)
+	} else {
+		cluster, err = client.GetCluster(ctx, org, env, slug)
+	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to get cluster: %w", err))
 	}
 	if cluster == nil {
 		return diag.FromErr(fmt.Errorf("cluster was not found"))
 	}
+	if err := checkAllowedEnvironment(provider, cluster.EnvironmentSlug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodePools, err := client.GetNodePoolsByCluster(ctx, *cluster)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get node pools of cluster %q: %w", cluster.Slug, err))
+	}
+	flattenedNodePools := make([]map[string]interface{}, len(nodePools))
+	for i, nodePool := range nodePools {
+		flattenedNodePools[i] = getNodePoolAttributes(nodePool)
+	}
 
 	d.SetId(cluster.Identity)
+	d.Set("identity", cluster.Identity)
 	d.Set("name", cluster.Name)
 	d.Set("description", cluster.Description)
 	d.Set("slug", cluster.Slug)
+	d.Set("environment", cluster.EnvironmentSlug)
+	d.Set("cni", cluster.CNI)
 	d.Set("cloud_account_identity", cluster.CloudAccount.Identity)
 	d.Set("delete_protection", cluster.DeleteProtection)
 	d.Set("cloud_provider", cluster.CloudProvider)
 	d.Set("region", cluster.Region)
 	d.Set("version", cluster.Version)
 	d.Set("update_channel", cluster.UpdateChannel)
+	d.Set("pod_security_standards_profile", cluster.PodSecurityStandardsProfile)
 	d.Set("enable_multi_availability_zones", cluster.EnableMultiAvailAbilityZones)
+	d.Set("enable_high_available_control_plane", cluster.HighlyAvailable)
+	d.Set("enable_private_cluster", cluster.EnableNATGateway)
+	d.Set("enable_network_encryption", cluster.EnableNetworkEncryption)
+	d.Set("enable_auto_upgrade", cluster.AutoUpgrade)
 	d.Set("status", cluster.Status)
 	if cluster.MaintenanceSchedule != nil {
 		d.Set("maintenance_schedule_id", cluster.MaintenanceSchedule.Identity)
 	} else {
 		d.Set("maintenance_schedule_id", "")
 	}
+	_, diags := setClusterNextMaintenanceWindows(ctx, d, client, org, cluster)
+	diags = append(diags, setClusterMaintenanceFreezeActive(ctx, d, client, org, cluster)...)
 	flattenedAddons := flattenClusterAddons(cluster.Addons)
 	d.Set("addons", flattenedAddons)
+	d.Set("node_pools", flattenedNodePools)
 
-	return nil
+	return diags
+}
+
+func getClusterByIdentity(ctx context.Context, client acloudapi.Client, org, identity string) (*acloudapi.Cluster, error) {
+	clusters, err := client.GetClusters(ctx, acloudapi.ListClusterOpts{
+		OrganisationSlug: org,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.Identity == identity {
+			return &cluster, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster with identity %q was not found in organisation %q", identity, org)
 }
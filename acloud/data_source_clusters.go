@@ -0,0 +1,184 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all clusters within an organisation or environment",
+		ReadContext: dataSourceClustersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the Organisation",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the Environment. Lists clusters of all environments allowed by the provider when not set.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the Cluster must match",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return clusters in this region",
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return clusters running on this Cloud Provider",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return clusters with this status",
+			},
+			"environment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(environmentTypes, false),
+				Description:  "Only return clusters in environments of this type. Available options: production, staging, development, demo, other",
+			},
+			"clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Clusters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID Identity of the Cluster",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Slug of the Environment of the Cluster",
+						},
+						"cloud_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	env := d.Get("environment").(string)
+	if env != "" {
+		if err := checkAllowedEnvironment(provider, env); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	regionFilter := d.Get("region").(string)
+	cloudProviderFilter := d.Get("cloud_provider").(string)
+	statusFilter := d.Get("status").(string)
+	environmentTypeFilter := d.Get("environment_type").(string)
+	nameFilter, err := getNameRegexFilter(d, "name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environmentTypeBySlug := map[string]string{}
+	if environmentTypeFilter != "" {
+		environments, err := client.GetEnvironments(ctx, org)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get environments for organisation %q: %w", org, err))
+		}
+		for _, environment := range environments {
+			environmentTypeBySlug[environment.Slug] = environment.Type
+		}
+	}
+
+	clusters, err := client.GetClusters(ctx, acloudapi.ListClusterOpts{
+		OrganisationSlug: org,
+		EnvironmentSlug:  env,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get clusters for organisation %q: %w", org, err))
+	}
+
+	setAsID(d, fmt.Sprintf("%s-%s", org, env))
+
+	result := make([]map[string]interface{}, 0, len(clusters))
+	for _, cluster := range clusters {
+		if !isAllowedEnvironment(provider, cluster.EnvironmentSlug) ||
+			!matchesStringFilter(env, cluster.EnvironmentSlug) ||
+			!matchesNameRegex(nameFilter, cluster.Name) ||
+			!matchesStringFilter(regionFilter, cluster.Region) ||
+			!matchesStringFilter(cloudProviderFilter, cluster.CloudProvider) ||
+			!matchesStringFilter(statusFilter, cluster.Status) ||
+			!matchesStringFilter(environmentTypeFilter, environmentTypeBySlug[cluster.EnvironmentSlug]) {
+			continue
+		}
+		result = append(result, getClusterAttributes(cluster))
+	}
+	d.Set("clusters", result)
+	return nil
+}
+
+func getClusterAttributes(cluster acloudapi.Cluster) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             cluster.Identity,
+		"name":           cluster.Name,
+		"slug":           cluster.Slug,
+		"environment":    cluster.EnvironmentSlug,
+		"cloud_provider": cluster.CloudProvider,
+		"region":         cluster.Region,
+		"version":        cluster.Version,
+		"update_channel": cluster.UpdateChannel,
+		"status":         cluster.Status,
+	}
+}
@@ -32,6 +32,26 @@ func dataSourceEnvironment() *schema.Resource {
 				Description: "Slug of the environment",
 				Required:    true,
 			},
+			"default_update_channel": {
+				Type:        schema.TypeString,
+				Description: "Update channel new clusters in the environment inherit",
+				Computed:    true,
+			},
+			"default_maintenance_schedule_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the maintenance schedule new clusters in the environment inherit",
+				Computed:    true,
+			},
+			"default_pod_security_standards_profile": {
+				Type:        schema.TypeString,
+				Description: "Pod Security Standards profile new clusters in the environment inherit",
+				Computed:    true,
+			},
+			"default_delete_protection": {
+				Type:        schema.TypeBool,
+				Description: "Do new clusters in the environment get delete protection",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -45,6 +65,9 @@ func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	slug := d.Get("slug").(string)
+	if err := checkAllowedEnvironment(provider, slug); err != nil {
+		return diag.FromErr(err)
+	}
 	environment, err := client.GetEnvironment(ctx, org, slug)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to get environment: %w", err))
@@ -53,5 +76,9 @@ func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m in
 	d.Set("name", environment.Name)
 	d.Set("organisation", org)
 	d.Set("slug", slug)
+	d.Set("default_update_channel", environment.DefaultUpdateChannel)
+	d.Set("default_maintenance_schedule_id", environment.DefaultMaintenanceScheduleIdentity)
+	d.Set("default_pod_security_standards_profile", environment.DefaultPodSecurityStandardsProfile)
+	d.Set("default_delete_protection", environment.DefaultDeleteProtection)
 	return nil
 }
@@ -0,0 +1,140 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceEnvironments() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all environments within an organisation that are allowed by the provider",
+		ReadContext: dataSourceEnvironmentsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the organisation",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the environment must match",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(environmentTypes, false),
+				Description:  "Only return environments of this type. Available options: production, staging, development, demo, other",
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of environments",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purpose": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_update_channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_maintenance_schedule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_pod_security_standards_profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_delete_protection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeFilter := d.Get("type").(string)
+	nameFilter, err := getNameRegexFilter(d, "name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environments, err := client.GetEnvironments(ctx, org)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get environments for organisation %q: %w", org, err))
+	}
+
+	d.SetId(org)
+
+	result := make([]map[string]interface{}, 0, len(environments))
+	for _, environment := range environments {
+		if !isAllowedEnvironment(provider, environment.Slug) ||
+			!matchesNameRegex(nameFilter, environment.Name) ||
+			!matchesStringFilter(typeFilter, environment.Type) {
+			continue
+		}
+		result = append(result, getEnvironmentAttributes(environment))
+	}
+	d.Set("environments", result)
+	return nil
+}
+
+func getEnvironmentAttributes(environment acloudapi.Environment) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                                     environment.ID,
+		"name":                                   environment.Name,
+		"slug":                                   environment.Slug,
+		"type":                                   environment.Type,
+		"purpose":                                environment.Purpose,
+		"description":                            environment.Description,
+		"default_update_channel":                 environment.DefaultUpdateChannel,
+		"default_maintenance_schedule_id":        environment.DefaultMaintenanceScheduleIdentity,
+		"default_pod_security_standards_profile": environment.DefaultPodSecurityStandardsProfile,
+		"default_delete_protection":              environment.DefaultDeleteProtection,
+	}
+}
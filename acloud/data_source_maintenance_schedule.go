@@ -2,53 +2,60 @@ package acloud
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 )
 
 func dataSourceMaintenanceSchedule() *schema.Resource {
 	return &schema.Resource{
+		Description: "Get a maintenance schedule by its ID or name",
 		ReadContext: dataMaintenanceScheduleRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the maintenance schedule",
 			},
 			"organisation": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Slug of the Organisation. Can only be set on creation.",
+				Optional:    true,
+				Description: "Slug of the Organisation",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Name of the maintenance schedule",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Name of the maintenance schedule",
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+				Description:  "IANA time zone to convert the maintenance windows to, such as `Europe/Amsterdam`",
 			},
 			"windows": {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "List of maintenance windows for the schedule",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"day": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Day of the maintenance window",
-						},
-						"start_time": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Start time of the maintenance window",
-						},
-						"duration": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Duration in minutes of the maintenance window",
-						},
-					},
-				},
+				Elem:        maintenanceWindowAttributesResource(),
 			},
+			"next_windows_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultNextMaintenanceWindowsCount,
+				ValidateFunc: validation.IntBetween(1, 52),
+				Description:  "Number of upcoming maintenance windows to return in next_windows",
+			},
+			"next_windows": nextMaintenanceWindowsSchema(),
 		},
 	}
 }
@@ -61,14 +68,78 @@ func dataMaintenanceScheduleRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	maintenanceSchedule, err := client.GetMaintenanceSchedule(ctx, org, d.Get("id").(string))
+	var maintenanceSchedule *acloudapi.MaintenanceSchedule
+	if id := d.Get("id").(string); id != "" {
+		maintenanceSchedule, err = client.GetMaintenanceSchedule(ctx, org, id)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get maintenance schedule %q: %w", id, err))
+		}
+		if maintenanceSchedule == nil {
+			return diag.FromErr(fmt.Errorf("maintenance schedule %q was not found in organisation %q", id, org))
+		}
+	} else {
+		maintenanceSchedules, err := client.GetMaintenanceSchedules(ctx, org)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get maintenance schedules for organisation %q: %w", org, err))
+		}
+		maintenanceSchedule, err = findMaintenanceScheduleByName(maintenanceSchedules, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("%w in organisation %q", err, org))
+		}
+	}
+
+	windows, err := maintenanceWindowsFromUTC(maintenanceSchedule.MaintenanceWindows, d.Get("time_zone").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(maintenanceSchedule.Identity)
 	d.Set("name", maintenanceSchedule.Name)
-	d.Set("windows", maintenanceSchedule.MaintenanceWindows)
+	d.Set("windows", flattenMaintenanceWindows(windows))
+
+	err = setNextMaintenanceWindows(d, "next_windows", maintenanceSchedule.MaintenanceWindows, d.Get("next_windows_count").(int), d.Get("time_zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
+
+func findMaintenanceScheduleByName(maintenanceSchedules []acloudapi.MaintenanceSchedule, name string) (*acloudapi.MaintenanceSchedule, error) {
+	var matches []acloudapi.MaintenanceSchedule
+	for _, maintenanceSchedule := range maintenanceSchedules {
+		if maintenanceSchedule.Name == name {
+			matches = append(matches, maintenanceSchedule)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("maintenance schedule named %q was not found", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d maintenance schedules named %q, use id instead", len(matches), name)
+	}
+}
+
+func maintenanceWindowAttributesResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"day": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Day of the maintenance window",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start time of the maintenance window",
+			},
+			"duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Duration in minutes of the maintenance window",
+			},
+		},
+	}
+}
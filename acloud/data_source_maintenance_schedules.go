@@ -0,0 +1,106 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMaintenanceSchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all maintenance schedules within an organisation",
+		ReadContext: dataSourceMaintenanceSchedulesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the Organisation",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the maintenance schedule must match",
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+				Description:  "IANA time zone to convert the maintenance windows to, such as `Europe/Amsterdam`",
+			},
+			"maintenance_schedules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of maintenance schedules",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the maintenance schedule",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the maintenance schedule",
+						},
+						"windows": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of maintenance windows for the schedule",
+							Elem:        maintenanceWindowAttributesResource(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMaintenanceSchedulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeZone := d.Get("time_zone").(string)
+	nameFilter, err := getNameRegexFilter(d, "name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maintenanceSchedules, err := client.GetMaintenanceSchedules(ctx, org)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get maintenance schedules for organisation %q: %w", org, err))
+	}
+
+	d.SetId(org)
+
+	result := make([]map[string]interface{}, 0, len(maintenanceSchedules))
+	for _, maintenanceSchedule := range maintenanceSchedules {
+		if !matchesNameRegex(nameFilter, maintenanceSchedule.Name) {
+			continue
+		}
+		windows, err := maintenanceWindowsFromUTC(maintenanceSchedule.MaintenanceWindows, timeZone)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("maintenance schedule %q: %w", maintenanceSchedule.Name, err))
+		}
+		result = append(result, map[string]interface{}{
+			"id":      maintenanceSchedule.Identity,
+			"name":    maintenanceSchedule.Name,
+			"windows": flattenMaintenanceWindows(windows),
+		})
+	}
+	d.Set("maintenance_schedules", result)
+	return nil
+}
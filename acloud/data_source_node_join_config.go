@@ -80,6 +80,9 @@ func dataSourceNodeJoinConfigRead(ctx context.Context, d *schema.ResourceData, m
 
 	environmentSlug := d.Get("environment").(string)
 	clusterSlug := d.Get("cluster").(string)
+	if err := checkAllowedEnvironment(provider, environmentSlug); err != nil {
+		return diag.FromErr(err)
+	}
 
 	cluster, err := client.GetCluster(ctx, org, environmentSlug, clusterSlug)
 	if err != nil {
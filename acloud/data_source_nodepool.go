@@ -7,26 +7,32 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"golang.org/x/exp/slices"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 )
 
 func dataSourceNodepool() *schema.Resource {
 	return &schema.Resource{
+		Description: "Get a node pool of a cluster by its ID, identity or name",
 		ReadContext: dataNodepoolRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "identity", "name"},
+				Description:  "Numeric ID of the Node Pool",
 			},
 			"identity": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "identity", "name"},
+				Description:  "UUID Identity of the Node Pool",
 			},
 			"organisation": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Description: "Slug of the Organisation.",
 			},
 			"environment": {
@@ -58,18 +64,69 @@ func dataSourceNodepool() *schema.Resource {
 				Default:    nil,
 			},
 			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "identity", "name"},
+				Description:  "Name of the Node Pool",
+			},
+			"availability_zone": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Name of the Node Pool",
+				Description: "Availability Zone in which the nodes were provisioned.",
 			},
-			"availability_zone": {
+			"node_size": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Availability Zone in which the nodes was provisioned.",
+				Computed:    true,
+				Description: "Type of machines in the Node Pool",
+			},
+			"auto_scaling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is auto scaling enabled for the Node Pool",
+			},
+			"min_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum amount of nodes in the Node Pool",
+			},
+			"max_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum amount of nodes in the Node Pool",
+			},
+			"node_auto_replacement": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Auto healing for nodes within this node pool",
+			},
+			"upgrade_strategy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Upgrade strategy for nodes in this pool",
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Annotations on the nodes in the Node Pool",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels on the nodes in the Node Pool",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"taints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Taints on the nodes in the Node Pool",
+				Elem:        nodeTaintAttributesResource(),
 			},
-		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
@@ -82,29 +139,74 @@ func dataNodepoolRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
 	}
+	if cluster == nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found"))
+	}
 
 	nodePools, err := client.GetNodePoolsByCluster(ctx, *cluster)
-
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to find node pool: %w", err))
 	}
 
-	nodePoolID, _ := strconv.Atoi(d.Get("id").(string))
-
-	idx := slices.IndexFunc(nodePools, func(pool acloudapi.NodePool) bool {
-		return pool.ID == nodePoolID
-	})
-
-	if idx == -1 {
-		return diag.FromErr(fmt.Errorf("nodepool was not found"))
+	nodePool, err := findNodePool(nodePools, d.Get("id").(string), d.Get("identity").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%w in cluster %q", err, cluster.Slug))
 	}
 
-	nodePool := nodePools[idx]
+	annotations := nodePool.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	labels := nodePool.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
 
 	d.SetId(strconv.Itoa(nodePool.ID))
+	d.Set("identity", nodePool.Identity)
 	d.Set("name", nodePool.Name)
 	d.Set("node_size", nodePool.NodeSize)
 	d.Set("auto_scaling", nodePool.AutoScaling)
 	d.Set("availability_zone", nodePool.AvailabilityZone)
+	d.Set("min_size", nodePool.MinSize)
+	d.Set("max_size", nodePool.MaxSize)
+	d.Set("node_auto_replacement", nodePool.NodeAutoReplacement)
+	d.Set("upgrade_strategy", string(nodePool.UpgradeStrategy))
+	d.Set("annotations", annotations)
+	d.Set("labels", labels)
+	d.Set("taints", flattenNodeTaints(nodePool.Taints))
 	return nil
 }
+
+// findNodePool returns the node pool matching the first non-empty lookup value of id, identity and name.
+// Names are not unique within a cluster, so a name matching more than one node pool is reported as an error.
+func findNodePool(nodePools []acloudapi.NodePool, id, identity, name string) (*acloudapi.NodePool, error) {
+	var matches []acloudapi.NodePool
+	for _, nodePool := range nodePools {
+		switch {
+		case id != "":
+			if strconv.Itoa(nodePool.ID) == id {
+				matches = append(matches, nodePool)
+			}
+		case identity != "":
+			if nodePool.Identity == identity {
+				matches = append(matches, nodePool)
+			}
+		case nodePool.Name == name:
+			matches = append(matches, nodePool)
+		}
+	}
+
+	switch {
+	case len(matches) == 1:
+		return &matches[0], nil
+	case len(matches) > 1:
+		return nil, fmt.Errorf("found %d node pools named %q, use id or identity instead", len(matches), name)
+	case id != "":
+		return nil, fmt.Errorf("node pool with id %q was not found", id)
+	case identity != "":
+		return nil, fmt.Errorf("node pool with identity %q was not found", identity)
+	default:
+		return nil, fmt.Errorf("node pool named %q was not found", name)
+	}
+}
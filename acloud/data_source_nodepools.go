@@ -0,0 +1,179 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceNodepools() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all node pools of a cluster",
+		ReadContext: dataSourceNodepoolsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the Organisation.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Slug of the Environment.",
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Slug of the Cluster.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the Node Pool must match",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return node pools in this Availability Zone",
+			},
+			"node_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Node Pools",
+				Elem:        nodePoolAttributesResource(),
+			},
+		},
+	}
+}
+
+func dataSourceNodepoolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	cluster, err := getClusterForNodePool(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
+	}
+	if cluster == nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found"))
+	}
+
+	availabilityZoneFilter := d.Get("availability_zone").(string)
+	nameFilter, err := getNameRegexFilter(d, "name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodePools, err := client.GetNodePoolsByCluster(ctx, *cluster)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get node pools: %w", err))
+	}
+
+	d.SetId(cluster.Identity)
+
+	result := make([]map[string]interface{}, 0, len(nodePools))
+	for _, nodePool := range nodePools {
+		if !matchesNameRegex(nameFilter, nodePool.Name) ||
+			!matchesStringFilter(availabilityZoneFilter, nodePool.AvailabilityZone) {
+			continue
+		}
+		result = append(result, getNodePoolAttributes(nodePool))
+	}
+	d.Set("node_pools", result)
+	return nil
+}
+
+func nodePoolAttributesResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"min_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_auto_replacement": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"upgrade_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     nodeTaintAttributesResource(),
+			},
+		},
+	}
+}
+
+func getNodePoolAttributes(nodePool acloudapi.NodePool) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    strconv.Itoa(nodePool.ID),
+		"identity":              nodePool.Identity,
+		"name":                  nodePool.Name,
+		"node_size":             nodePool.NodeSize,
+		"availability_zone":     nodePool.AvailabilityZone,
+		"auto_scaling":          nodePool.AutoScaling,
+		"min_size":              nodePool.MinSize,
+		"max_size":              nodePool.MaxSize,
+		"node_auto_replacement": nodePool.NodeAutoReplacement,
+		"upgrade_strategy":      string(nodePool.UpgradeStrategy),
+		"annotations":           nodePool.Annotations,
+		"labels":                nodePool.Labels,
+		"taints":                flattenNodeTaints(nodePool.Taints),
+	}
+}
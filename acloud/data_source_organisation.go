@@ -29,6 +29,11 @@ func dataSourceOrganisations() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Role of the caller within the organisation",
+			},
 		},
 	}
 }
@@ -51,8 +56,9 @@ func dataSourceOrganisationsRead(ctx context.Context, d *schema.ResourceData, m
 			d.Set("name", org.Name)
 			d.Set("slug", org.Slug)
 			d.Set("email", org.Email)
+			d.Set("role", org.Role)
 			return nil
 		}
 	}
-	return diag.FromErr(fmt.Errorf("not found"))
+	return diag.FromErr(fmt.Errorf("organisation %q was not found in the memberships of the configured token", slug))
 }
@@ -0,0 +1,83 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceOrganisationMemberships() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all organisations the configured token is a member of",
+		ReadContext: dataSourceOrganisationMembershipsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of organisations",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role of the caller within the organisation",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrganisationMembershipsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	memberships, err := client.GetMemberships(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get organisation memberships: %w", err))
+	}
+
+	d.SetId("memberships")
+
+	organisations := make([]map[string]interface{}, len(memberships))
+	for i, membership := range memberships {
+		organisations[i] = getMembershipAttributes(membership)
+	}
+	d.Set("organisations", organisations)
+	return nil
+}
+
+func getMembershipAttributes(membership acloudapi.Membership) map[string]interface{} {
+	return map[string]interface{}{
+		"id":    membership.ID,
+		"name":  membership.Name,
+		"slug":  membership.Slug,
+		"email": membership.Email,
+		"role":  membership.Role,
+	}
+}
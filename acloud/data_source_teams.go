@@ -0,0 +1,110 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func dataSourceTeams() *schema.Resource {
+	return &schema.Resource{
+		Description: "List all teams within an organisation",
+		ReadContext: dataSourceTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of the Organisation",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the team must match",
+			},
+			"teams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of teams",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Email addresses of the members of the team",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nameFilter, err := getNameRegexFilter(d, "name_regex")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teams, err := client.GetTeams(ctx, org)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get teams for organisation %q: %w", org, err))
+	}
+
+	d.SetId(org)
+
+	result := make([]map[string]interface{}, 0, len(teams))
+	for _, team := range teams {
+		if !matchesNameRegex(nameFilter, team.Name) {
+			continue
+		}
+		result = append(result, getTeamAttributes(team))
+	}
+	d.Set("teams", result)
+	return nil
+}
+
+func getTeamAttributes(team acloudapi.Team) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          team.Identity,
+		"name":        team.Name,
+		"slug":        team.Slug,
+		"description": team.Description,
+		"members":     team.Members,
+	}
+}
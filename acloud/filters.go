@@ -0,0 +1,48 @@
+package acloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getNameRegexFilter compiles the regular expression stored in the given attribute.
+// It returns nil when the attribute is not set, meaning every name matches.
+func getNameRegexFilter(d *schema.ResourceData, attribute string) (*regexp.Regexp, error) {
+	pattern := d.Get(attribute).(string)
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", attribute, pattern, err)
+	}
+	return re, nil
+}
+
+func matchesNameRegex(re *regexp.Regexp, name string) bool {
+	return re == nil || re.MatchString(name)
+}
+
+func matchesStringFilter(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
+// getOptionalBoolFilter returns the value of a boolean attribute, or nil when it is not set in the configuration.
+// Unlike d.GetOk, this distinguishes an explicit false from an unset attribute.
+func getOptionalBoolFilter(d *schema.ResourceData, attribute string) *bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	raw := config.GetAttr(attribute)
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	return ToPtr(raw.True())
+}
+
+func matchesBoolFilter(filter *bool, value bool) bool {
+	return filter == nil || *filter == value
+}
@@ -0,0 +1,91 @@
+package acloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNameRegexFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "not set matches everything", pattern: "", value: "production", want: true},
+		{name: "partial match", pattern: "prod", value: "my-production-cluster", want: true},
+		{name: "anchored match", pattern: "^prod-[0-9]+$", value: "prod-12", want: true},
+		{name: "anchored mismatch", pattern: "^prod-[0-9]+$", value: "prod-12-old", want: false},
+		{name: "no match", pattern: "staging", value: "production", want: false},
+		{name: "invalid pattern", pattern: "prod(", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"name_regex": {Type: schema.TypeString, Optional: true},
+			}, map[string]interface{}{"name_regex": tt.pattern})
+
+			re, err := getNameRegexFilter(d, "name_regex")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getNameRegexFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := matchesNameRegex(re, tt.value); got != tt.want {
+				t.Errorf("matchesNameRegex(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesStringFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		value  string
+		want   bool
+	}{
+		{name: "not set matches everything", filter: "", value: "running", want: true},
+		{name: "not set matches empty value", filter: "", value: "", want: true},
+		{name: "equal", filter: "running", value: "running", want: true},
+		{name: "different", filter: "running", value: "stopped", want: false},
+		{name: "case sensitive", filter: "Running", value: "running", want: false},
+		{name: "set does not match empty value", filter: "running", value: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesStringFilter(tt.filter, tt.value); got != tt.want {
+				t.Errorf("matchesStringFilter(%q, %q) = %v, want %v", tt.filter, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesBoolFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *bool
+		value  bool
+		want   bool
+	}{
+		{name: "not set matches true", filter: nil, value: true, want: true},
+		{name: "not set matches false", filter: nil, value: false, want: true},
+		{name: "true matches true", filter: ToPtr(true), value: true, want: true},
+		{name: "true does not match false", filter: ToPtr(true), value: false, want: false},
+		{name: "false matches false", filter: ToPtr(false), value: false, want: true},
+		{name: "false does not match true", filter: ToPtr(false), value: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesBoolFilter(tt.filter, tt.value); got != tt.want {
+				t.Errorf("matchesBoolFilter(%v, %v) = %v, want %v", tt.filter, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package acloud
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+const kuredAddonName = "kured"
+
+// kuredRebootWindow derives the kured reboot window from the maintenance windows of a schedule.
+// Kured only supports a single time of day for all reboot days, so the window is the longest part of the
+// day that is covered by every maintenance window.
+func kuredRebootWindow(windows []acloudapi.MaintenanceWindow) (map[string]string, error) {
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("maintenance schedule has no maintenance windows")
+	}
+
+	// every window is laid out on the minutes of the day, wrapping past midnight, so a window on monday 23:00
+	// for two hours shares 00:00 to 01:00 with a window on tuesday 00:00
+	starts := make([]int, len(windows))
+	covered := make([]int, minutesPerDay)
+	for i, window := range windows {
+		windowStart, err := maintenanceWindowStart(window)
+		if err != nil {
+			return nil, err
+		}
+		starts[i] = windowStart
+		for minute := 0; minute < min(window.Duration, minutesPerDay); minute++ {
+			covered[(windowStart+minute)%minutesPerDay]++
+		}
+	}
+
+	start, length := longestCoveredPeriod(covered, len(windows))
+	if length == 0 {
+		return nil, fmt.Errorf("maintenance windows do not share a common time of day, a kured reboot window cannot be derived")
+	}
+
+	// the reboot window starts on the day of a maintenance window, or on the next day when it starts past midnight
+	rebootDay := make([]bool, len(maintenanceWindowDays))
+	for _, windowStart := range starts {
+		offset := (start - windowStart%minutesPerDay + minutesPerDay) % minutesPerDay
+		rebootDay[(windowStart+offset)/minutesPerDay%len(maintenanceWindowDays)] = true
+	}
+	var rebootDays []string
+	for i, day := range maintenanceWindowDays {
+		if rebootDay[i] {
+			rebootDays = append(rebootDays, day[:3])
+		}
+	}
+
+	return map[string]string{
+		"startTime":  formatMinuteOfDay(start),
+		"endTime":    formatMinuteOfDay(start + length),
+		"timeZone":   "UTC",
+		"rebootDays": strings.Join(rebootDays, ","),
+	}, nil
+}
+
+// longestCoveredPeriod returns the start and length in minutes of the longest period of the day, possibly wrapping
+// past midnight, during which every one of count windows is open.
+func longestCoveredPeriod(covered []int, count int) (start, length int) {
+	first := slices.IndexFunc(covered, func(c int) bool { return c < count })
+	if first == -1 {
+		// covered all day, end a minute early as an equal start and end time would be an empty window
+		return 0, minutesPerDay - 1
+	}
+
+	// scan from an uncovered minute, so a period wrapping past midnight is not split in two
+	run := 0
+	for i := 1; i <= minutesPerDay; i++ {
+		minute := (first + i) % minutesPerDay
+		if covered[minute] < count {
+			run = 0
+			continue
+		}
+		run++
+		if run > length {
+			start, length = (minute-run+1+minutesPerDay)%minutesPerDay, run
+		}
+	}
+	return start, length
+}
+
+// applyKuredRebootWindow overrides the reboot window of the kured add-on, keeping its other custom values.
+func applyKuredRebootWindow(addons map[string]acloudapi.APIAddon, windows []acloudapi.MaintenanceWindow) (map[string]acloudapi.APIAddon, error) {
+	rebootWindow, err := kuredRebootWindow(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := maps.Clone(addons)
+	if result == nil {
+		result = map[string]acloudapi.APIAddon{}
+	}
+	kured := result[kuredAddonName]
+	customValues := maps.Clone(kured.CustomValues)
+	if customValues == nil {
+		customValues = map[string]string{}
+	}
+	maps.Copy(customValues, rebootWindow)
+	kured.CustomValues = customValues
+	result[kuredAddonName] = kured
+	return result, nil
+}
+
+// kuredOutsideMaintenanceSchedule reports whether the kured add-on has a customised reboot window that is not
+// covered by the maintenance windows. The default reboot window is not compared.
+func kuredOutsideMaintenanceSchedule(addons map[string]acloudapi.APIAddon, windows []acloudapi.MaintenanceWindow) (bool, error) {
+	kured, ok := addons[kuredAddonName]
+	if !ok || maps.Equal(kured.CustomValues, defaultClusterAddons()[kuredAddonName].CustomValues) {
+		return false, nil
+	}
+	return kuredRebootWindowOutsideSchedule(kured, windows)
+}
+
+// kuredRebootWindowOutsideSchedule reports whether the reboot window configured on the kured add-on
+// is not fully covered by the maintenance windows of the schedule.
+func kuredRebootWindowOutsideSchedule(kured acloudapi.APIAddon, windows []acloudapi.MaintenanceWindow) (bool, error) {
+	if !kured.Enabled || len(windows) == 0 {
+		return false, nil
+	}
+
+	startTime, err := time.Parse("15:04", kured.CustomValues["startTime"])
+	if err != nil {
+		return false, fmt.Errorf("invalid kured startTime %q: %w", kured.CustomValues["startTime"], err)
+	}
+	endTime, err := time.Parse("15:04", kured.CustomValues["endTime"])
+	if err != nil {
+		return false, fmt.Errorf("invalid kured endTime %q: %w", kured.CustomValues["endTime"], err)
+	}
+	location := time.UTC
+	if timeZone := kured.CustomValues["timeZone"]; timeZone != "" {
+		location, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return false, fmt.Errorf("invalid kured timeZone %q: %w", timeZone, err)
+		}
+	}
+
+	duration := endTime.Hour()*60 + endTime.Minute() - startTime.Hour()*60 - startTime.Minute()
+	if duration <= 0 {
+		duration += minutesPerDay
+	}
+
+	for _, rebootDay := range strings.Split(kured.CustomValues["rebootDays"], ",") {
+		rebootDay = strings.ToLower(strings.TrimSpace(rebootDay))
+		if rebootDay == "" {
+			continue
+		}
+		// kured accepts full day names and their three letter abbreviations
+		day := slices.IndexFunc(maintenanceWindowDays, func(name string) bool {
+			return rebootDay == name || rebootDay == name[:3]
+		})
+		if day == -1 {
+			return false, fmt.Errorf("invalid kured reboot day %q", rebootDay)
+		}
+		rebootWindow, err := convertMaintenanceWindows([]acloudapi.MaintenanceWindow{{
+			Day:       maintenanceWindowDays[day],
+			StartTime: startTime.Format("15:04"),
+			Duration:  duration,
+		}}, location, time.UTC, time.Now())
+		if err != nil {
+			return false, err
+		}
+		start, err := maintenanceWindowStart(rebootWindow[0])
+		if err != nil {
+			return false, err
+		}
+		if !maintenanceWindowsCover(windows, start, duration) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// maintenanceWindowsCover reports whether a single maintenance window contains the given period of the week.
+func maintenanceWindowsCover(windows []acloudapi.MaintenanceWindow, start, duration int) bool {
+	start = ((start % minutesPerWeek) + minutesPerWeek) % minutesPerWeek
+	for _, window := range windows {
+		windowStart, err := maintenanceWindowStart(window)
+		if err != nil {
+			continue
+		}
+		for _, shift := range []int{-minutesPerWeek, 0} {
+			if windowStart+shift <= start && start+duration <= windowStart+shift+window.Duration {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatMinuteOfDay(minutes int) string {
+	minutes = minutes % minutesPerDay
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,192 @@
+package acloud
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func TestKuredRebootWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []acloudapi.MaintenanceWindow
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "single window",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "sunday", StartTime: "02:00", Duration: 240},
+			},
+			want: map[string]string{"startTime": "02:00", "endTime": "06:00", "timeZone": "UTC", "rebootDays": "sun"},
+		},
+		{
+			name: "common time of day",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "tuesday", StartTime: "03:00", Duration: 120},
+				{Day: "monday", StartTime: "02:00", Duration: 120},
+			},
+			want: map[string]string{"startTime": "03:00", "endTime": "04:00", "timeZone": "UTC", "rebootDays": "mon,tue"},
+		},
+		{
+			name: "window wrapping past midnight",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "23:00", Duration: 120},
+				{Day: "tuesday", StartTime: "00:00", Duration: 60},
+			},
+			want: map[string]string{"startTime": "00:00", "endTime": "01:00", "timeZone": "UTC", "rebootDays": "tue"},
+		},
+		{
+			name: "windows wrapping past midnight",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "friday", StartTime: "22:00", Duration: 240},
+				{Day: "saturday", StartTime: "23:00", Duration: 120},
+			},
+			want: map[string]string{"startTime": "23:00", "endTime": "01:00", "timeZone": "UTC", "rebootDays": "fri,sat"},
+		},
+		{
+			name: "window wrapping past the end of the week",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "sunday", StartTime: "23:00", Duration: 120},
+				{Day: "wednesday", StartTime: "00:00", Duration: 60},
+			},
+			want: map[string]string{"startTime": "00:00", "endTime": "01:00", "timeZone": "UTC", "rebootDays": "mon,wed"},
+		},
+		{
+			name: "windows covering the whole day",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "saturday", StartTime: "00:00", Duration: 1440},
+			},
+			want: map[string]string{"startTime": "00:00", "endTime": "23:59", "timeZone": "UTC", "rebootDays": "sat"},
+		},
+		{
+			name: "no common time of day",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "02:00", Duration: 60},
+				{Day: "tuesday", StartTime: "04:00", Duration: 60},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no windows",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kuredRebootWindow(tt.windows)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKuredRebootWindowOutsideSchedule(t *testing.T) {
+	windows := []acloudapi.MaintenanceWindow{
+		{Day: "monday", StartTime: "01:00", Duration: 180},
+		{Day: "saturday", StartTime: "23:00", Duration: 240},
+	}
+
+	tests := []struct {
+		name         string
+		customValues map[string]string
+		enabled      bool
+		want         bool
+		wantErr      bool
+	}{
+		{
+			name:         "covered",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "mon"},
+			enabled:      true,
+		},
+		{
+			name:         "covered past midnight",
+			customValues: map[string]string{"startTime": "23:00", "endTime": "02:00", "timeZone": "UTC", "rebootDays": "sat"},
+			enabled:      true,
+		},
+		{
+			name:         "covered in another time zone",
+			customValues: map[string]string{"startTime": "03:00", "endTime": "04:00", "timeZone": "Europe/Amsterdam", "rebootDays": "mon"},
+			enabled:      true,
+		},
+		{
+			name:         "outside on one of the days",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "mon,tue"},
+			enabled:      true,
+			want:         true,
+		},
+		{
+			name:         "longer than the window",
+			customValues: map[string]string{"startTime": "00:00", "endTime": "06:00", "timeZone": "UTC", "rebootDays": "mon"},
+			enabled:      true,
+			want:         true,
+		},
+		{
+			name:         "disabled",
+			customValues: map[string]string{"startTime": "12:00", "endTime": "13:00", "timeZone": "UTC", "rebootDays": "wed"},
+		},
+		{
+			name:         "invalid reboot day",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "someday"},
+			enabled:      true,
+			wantErr:      true,
+		},
+		{
+			name:         "full day name",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "Monday"},
+			enabled:      true,
+		},
+		{
+			name:         "full day name outside",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "monday, tuesday"},
+			enabled:      true,
+			want:         true,
+		},
+		{
+			name:         "invalid reboot day single letter t",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "t"},
+			enabled:      true,
+			wantErr:      true,
+		},
+		{
+			name:         "invalid reboot day single letter s",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "s"},
+			enabled:      true,
+			wantErr:      true,
+		},
+		{
+			name:         "invalid reboot day two letter prefix",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "th"},
+			enabled:      true,
+			wantErr:      true,
+		},
+		{
+			name:         "invalid reboot day longer prefix",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "UTC", "rebootDays": "thurs"},
+			enabled:      true,
+			wantErr:      true,
+		},
+		{
+			name:         "invalid time zone",
+			customValues: map[string]string{"startTime": "01:30", "endTime": "03:30", "timeZone": "Mars/Olympus", "rebootDays": "mon"},
+			enabled:      true,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kuredRebootWindowOutsideSchedule(acloudapi.APIAddon{Enabled: tt.enabled, CustomValues: tt.customValues}, windows)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
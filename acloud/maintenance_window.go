@@ -0,0 +1,226 @@
+package acloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	// embed the time zone database, as not every platform running Terraform ships one
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+
+	minMaintenanceWindowDuration = 30
+	maxMaintenanceWindowDuration = minutesPerDay
+
+	defaultNextMaintenanceWindowsCount = 3
+)
+
+// maintenanceWindowDays lists the days accepted by the API, in week order starting on monday.
+var maintenanceWindowDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+var maintenanceWindowStartTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// maintenanceWindowStart returns the start of the window in minutes since monday 00:00.
+func maintenanceWindowStart(window acloudapi.MaintenanceWindow) (int, error) {
+	day := slices.Index(maintenanceWindowDays, strings.ToLower(window.Day))
+	if day == -1 {
+		return 0, fmt.Errorf("invalid maintenance window day %q", window.Day)
+	}
+	if !maintenanceWindowStartTimeRegexp.MatchString(window.StartTime) {
+		return 0, fmt.Errorf("invalid maintenance window start time %q, expected HH:MM", window.StartTime)
+	}
+	startTime, err := time.Parse("15:04", window.StartTime)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maintenance window start time %q: %w", window.StartTime, err)
+	}
+	return day*minutesPerDay + startTime.Hour()*60 + startTime.Minute(), nil
+}
+
+func maintenanceWindowFromStart(start, duration int) acloudapi.MaintenanceWindow {
+	start = ((start % minutesPerWeek) + minutesPerWeek) % minutesPerWeek
+	minuteOfDay := start % minutesPerDay
+	return acloudapi.MaintenanceWindow{
+		Day:       maintenanceWindowDays[start/minutesPerDay],
+		StartTime: fmt.Sprintf("%02d:%02d", minuteOfDay/60, minuteOfDay%60),
+		Duration:  duration,
+	}
+}
+
+// maintenanceWindowsToUTC converts windows in the given time zone to the UTC windows stored by the API.
+func maintenanceWindowsToUTC(windows []acloudapi.MaintenanceWindow, timeZone string) ([]acloudapi.MaintenanceWindow, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+	return convertMaintenanceWindows(windows, location, time.UTC, time.Now())
+}
+
+// maintenanceWindowsFromUTC converts UTC windows returned by the API to the given time zone.
+func maintenanceWindowsFromUTC(windows []acloudapi.MaintenanceWindow, timeZone string) ([]acloudapi.MaintenanceWindow, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+	return convertMaintenanceWindows(windows, time.UTC, location, time.Now())
+}
+
+// convertMaintenanceWindows converts windows from one time zone to another. The offset between the time zones
+// is taken at the next occurrence of every window, so windows keep their local time across daylight saving changes.
+func convertMaintenanceWindows(windows []acloudapi.MaintenanceWindow, from, to *time.Location, now time.Time) ([]acloudapi.MaintenanceWindow, error) {
+	converted := make([]acloudapi.MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		start, err := maintenanceWindowStart(window)
+		if err != nil {
+			return nil, err
+		}
+		occurrence := nextMaintenanceWindowOccurrence(start, window.Duration, from, now).In(to)
+		weekday := (int(occurrence.Weekday()) + 6) % 7
+		converted = append(converted, maintenanceWindowFromStart(weekday*minutesPerDay+occurrence.Hour()*60+occurrence.Minute(), window.Duration))
+	}
+	return converted, nil
+}
+
+// nextMaintenanceWindowOccurrence returns the start of the first occurrence of a window, given in minutes since
+// monday 00:00 in the location, that has not ended yet at now.
+func nextMaintenanceWindowOccurrence(start, duration int, location *time.Location, now time.Time) time.Time {
+	now = now.In(location)
+	weekday := (int(now.Weekday()) + 6) % 7
+	minuteOfDay := start % minutesPerDay
+	for week := -1; ; week++ {
+		occurrence := time.Date(now.Year(), now.Month(), now.Day()-weekday+week*7+start/minutesPerDay, minuteOfDay/60, minuteOfDay%60, 0, 0, location)
+		if occurrence.Add(time.Duration(duration) * time.Minute).After(now) {
+			return occurrence
+		}
+	}
+}
+
+// validateMaintenanceWindowsOverlap returns an error for the first pair of windows that overlap within the week.
+func validateMaintenanceWindowsOverlap(windows []acloudapi.MaintenanceWindow) error {
+	starts := make([]int, len(windows))
+	for i, window := range windows {
+		start, err := maintenanceWindowStart(window)
+		if err != nil {
+			return err
+		}
+		starts[i] = start
+	}
+
+	for i := range windows {
+		for j := i + 1; j < len(windows); j++ {
+			if maintenanceWindowsOverlap(starts[i], windows[i].Duration, starts[j], windows[j].Duration) {
+				return fmt.Errorf("maintenance window %d (%s %s) overlaps with maintenance window %d (%s %s)",
+					i, windows[i].Day, windows[i].StartTime, j, windows[j].Day, windows[j].StartTime)
+			}
+		}
+	}
+	return nil
+}
+
+func maintenanceWindowsOverlap(startA, durationA, startB, durationB int) bool {
+	// windows wrap around the end of the week, so compare against B shifted a week back and forward as well
+	for _, shift := range []int{-minutesPerWeek, 0, minutesPerWeek} {
+		if startA < startB+shift+durationB && startB+shift < startA+durationA {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenMaintenanceWindows(windows []acloudapi.MaintenanceWindow) []interface{} {
+	result := make([]interface{}, len(windows))
+	for i, window := range windows {
+		result[i] = map[string]interface{}{
+			"day":        window.Day,
+			"start_time": window.StartTime,
+			"duration":   window.Duration,
+		}
+	}
+	return result
+}
+
+// nextMaintenanceWindows returns the first count occurrences of the given UTC windows that have not ended yet at now.
+// Start and end timestamps are formatted as RFC 3339 in the given location.
+func nextMaintenanceWindows(windows []acloudapi.MaintenanceWindow, now time.Time, count int, location *time.Location) ([]interface{}, error) {
+	now = now.UTC()
+	weekday := (int(now.Weekday()) + 6) % 7
+	weekStart := time.Date(now.Year(), now.Month(), now.Day()-weekday, 0, 0, 0, 0, time.UTC)
+
+	type occurrence struct {
+		start time.Time
+		end   time.Time
+	}
+
+	var occurrences []occurrence
+	for week := -1; week <= count; week++ {
+		for _, window := range windows {
+			start, err := maintenanceWindowStart(window)
+			if err != nil {
+				return nil, err
+			}
+			startTime := weekStart.Add(time.Duration(week*minutesPerWeek+start) * time.Minute)
+			endTime := startTime.Add(time.Duration(window.Duration) * time.Minute)
+			if endTime.After(now) {
+				occurrences = append(occurrences, occurrence{start: startTime, end: endTime})
+			}
+		}
+	}
+
+	slices.SortFunc(occurrences, func(a, b occurrence) int {
+		return a.start.Compare(b.start)
+	})
+	if len(occurrences) > count {
+		occurrences = occurrences[:count]
+	}
+
+	result := make([]interface{}, len(occurrences))
+	for i, o := range occurrences {
+		result[i] = map[string]interface{}{
+			"start": o.start.In(location).Format(time.RFC3339),
+			"end":   o.end.In(location).Format(time.RFC3339),
+		}
+	}
+	return result, nil
+}
+
+func nextMaintenanceWindowsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Upcoming occurrences of the maintenance windows, including a window that is currently in progress",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Start of the maintenance window as an RFC 3339 timestamp",
+				},
+				"end": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "End of the maintenance window as an RFC 3339 timestamp",
+				},
+			},
+		},
+	}
+}
+
+func setNextMaintenanceWindows(d *schema.ResourceData, key string, windows []acloudapi.MaintenanceWindow, count int, timeZone string) error {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+	nextWindows, err := nextMaintenanceWindows(windows, time.Now(), count, location)
+	if err != nil {
+		return err
+	}
+	return d.Set(key, nextWindows)
+}
@@ -0,0 +1,269 @@
+package acloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+func TestConvertMaintenanceWindows(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		window acloudapi.MaintenanceWindow
+		from   *time.Location
+		to     *time.Location
+		now    time.Time
+		want   acloudapi.MaintenanceWindow
+	}{
+		{
+			name:   "to UTC in winter time",
+			window: acloudapi.MaintenanceWindow{Day: "monday", StartTime: "02:00", Duration: 60},
+			from:   amsterdam,
+			to:     time.UTC,
+			now:    time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "monday", StartTime: "01:00", Duration: 60},
+		},
+		{
+			name:   "to UTC in summer time",
+			window: acloudapi.MaintenanceWindow{Day: "monday", StartTime: "02:00", Duration: 60},
+			from:   amsterdam,
+			to:     time.UTC,
+			now:    time.Date(2024, time.July, 10, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "monday", StartTime: "00:00", Duration: 60},
+		},
+		{
+			name:   "to UTC moves to the previous day",
+			window: acloudapi.MaintenanceWindow{Day: "monday", StartTime: "00:30", Duration: 60},
+			from:   amsterdam,
+			to:     time.UTC,
+			now:    time.Date(2024, time.July, 10, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "sunday", StartTime: "22:30", Duration: 60},
+		},
+		{
+			name:   "to UTC uses the offset after a daylight saving change before the next occurrence",
+			window: acloudapi.MaintenanceWindow{Day: "monday", StartTime: "02:00", Duration: 60},
+			from:   amsterdam,
+			to:     time.UTC,
+			now:    time.Date(2024, time.March, 30, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "monday", StartTime: "00:00", Duration: 60},
+		},
+		{
+			name:   "to UTC uses the offset of a window in progress",
+			window: acloudapi.MaintenanceWindow{Day: "saturday", StartTime: "12:00", Duration: 120},
+			from:   amsterdam,
+			to:     time.UTC,
+			now:    time.Date(2024, time.March, 30, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "saturday", StartTime: "11:00", Duration: 120},
+		},
+		{
+			name:   "from UTC moves to the next day",
+			window: acloudapi.MaintenanceWindow{Day: "sunday", StartTime: "23:00", Duration: 60},
+			from:   time.UTC,
+			to:     amsterdam,
+			now:    time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "monday", StartTime: "00:00", Duration: 60},
+		},
+		{
+			name:   "UTC to UTC",
+			window: acloudapi.MaintenanceWindow{Day: "Friday", StartTime: "18:15", Duration: 30},
+			from:   time.UTC,
+			to:     time.UTC,
+			now:    time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC),
+			want:   acloudapi.MaintenanceWindow{Day: "friday", StartTime: "18:15", Duration: 30},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertMaintenanceWindows([]acloudapi.MaintenanceWindow{tt.window}, tt.from, tt.to, tt.now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got[0] != tt.want {
+				t.Errorf("got %+v, want %+v", got[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertMaintenanceWindowsRoundTrip(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	windows := []acloudapi.MaintenanceWindow{
+		{Day: "monday", StartTime: "00:30", Duration: 60},
+		{Day: "wednesday", StartTime: "13:00", Duration: 30},
+		{Day: "sunday", StartTime: "23:45", Duration: 240},
+	}
+
+	utc, err := convertMaintenanceWindows(windows, amsterdam, time.UTC, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := convertMaintenanceWindows(utc, time.UTC, amsterdam, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range windows {
+		if got[i] != windows[i] {
+			t.Errorf("window %d: got %+v, want %+v", i, got[i], windows[i])
+		}
+	}
+}
+
+func TestValidateMaintenanceWindowsOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []acloudapi.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name: "separate days",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "02:00", Duration: 120},
+				{Day: "tuesday", StartTime: "02:00", Duration: 120},
+			},
+		},
+		{
+			name: "adjacent windows",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "02:00", Duration: 60},
+				{Day: "monday", StartTime: "03:00", Duration: 60},
+			},
+		},
+		{
+			name: "overlap on the same day",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "02:00", Duration: 120},
+				{Day: "monday", StartTime: "03:00", Duration: 60},
+			},
+			wantErr: true,
+		},
+		{
+			name: "overlap past midnight",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "23:00", Duration: 120},
+				{Day: "tuesday", StartTime: "00:30", Duration: 60},
+			},
+			wantErr: true,
+		},
+		{
+			name: "overlap past the end of the week",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "00:30", Duration: 60},
+				{Day: "sunday", StartTime: "23:00", Duration: 120},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid day",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "Mon", StartTime: "02:00", Duration: 60},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid start time",
+			windows: []acloudapi.MaintenanceWindow{
+				{Day: "monday", StartTime: "25:00", Duration: 60},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMaintenanceWindowsOverlap(tt.windows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNextMaintenanceWindows(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	windows := []acloudapi.MaintenanceWindow{
+		{Day: "monday", StartTime: "02:00", Duration: 60},
+		{Day: "wednesday", StartTime: "22:00", Duration: 180},
+	}
+
+	tests := []struct {
+		name     string
+		windows  []acloudapi.MaintenanceWindow
+		now      time.Time
+		count    int
+		location *time.Location
+		want     []map[string]string
+	}{
+		{
+			name:     "includes the window in progress",
+			windows:  windows,
+			now:      time.Date(2024, time.January, 10, 23, 0, 0, 0, time.UTC),
+			count:    3,
+			location: time.UTC,
+			want: []map[string]string{
+				{"start": "2024-01-10T22:00:00Z", "end": "2024-01-11T01:00:00Z"},
+				{"start": "2024-01-15T02:00:00Z", "end": "2024-01-15T03:00:00Z"},
+				{"start": "2024-01-17T22:00:00Z", "end": "2024-01-18T01:00:00Z"},
+			},
+		},
+		{
+			name:     "skips windows that have ended",
+			windows:  windows,
+			now:      time.Date(2024, time.January, 11, 1, 0, 0, 0, time.UTC),
+			count:    1,
+			location: time.UTC,
+			want: []map[string]string{
+				{"start": "2024-01-15T02:00:00Z", "end": "2024-01-15T03:00:00Z"},
+			},
+		},
+		{
+			name:     "window in progress from the previous week",
+			windows:  []acloudapi.MaintenanceWindow{{Day: "sunday", StartTime: "23:00", Duration: 300}},
+			now:      time.Date(2024, time.January, 15, 1, 0, 0, 0, time.UTC),
+			count:    2,
+			location: time.UTC,
+			want: []map[string]string{
+				{"start": "2024-01-14T23:00:00Z", "end": "2024-01-15T04:00:00Z"},
+				{"start": "2024-01-21T23:00:00Z", "end": "2024-01-22T04:00:00Z"},
+			},
+		},
+		{
+			name:     "formatted in the location",
+			windows:  windows,
+			now:      time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC),
+			count:    1,
+			location: amsterdam,
+			want: []map[string]string{
+				{"start": "2024-07-04T00:00:00+02:00", "end": "2024-07-04T03:00:00+02:00"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nextMaintenanceWindows(tt.windows, tt.now, tt.count, tt.location)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d windows, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				window := got[i].(map[string]interface{})
+				if window["start"] != want["start"] || window["end"] != want["end"] {
+					t.Errorf("window %d: got %v, want %v", i, window, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const productionEnvironmentType = "production"
+
+func allowProductionDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow destroying or replacing this resource when it belongs to a production environment. Replacements are checked during plan, but destroying reads the value from the state, so it must be applied before destroying the resource. Use allow_production_destroy of the provider to destroy resources in a single run.",
+	}
+}
+
+// customizeProductionReplacementDiff refuses plans that replace a resource within a production environment,
+// unless allow_production_destroy is set on the resource or the provider. The environment is looked up
+// using the prior values of environmentAttributes, as the resource that is replaced lives in that environment.
+// Like getStringAttributeWithLegacyName, a later attribute such as a deprecated name takes precedence.
+func customizeProductionReplacementDiff(resourceSchema map[string]*schema.Schema, environmentAttributes ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		replacedBy := replacedAttributes(d, resourceSchema)
+		if len(replacedBy) == 0 {
+			return nil
+		}
+
+		provider := getProvider(m)
+		if provider.AllowProductionDestroy || d.Get("allow_production_destroy").(bool) {
+			return nil
+		}
+
+		prior := priorValues{d}
+		org := getStringAttributeWithLegacyName(prior, "organisation", "organisation_slug")
+		if org == "" {
+			org = provider.Organisation
+		}
+		env := getStringAttributeWithLegacyName(prior, environmentAttributes...)
+		production, err := isProductionEnvironment(ctx, provider.Client, org, env)
+		if err != nil {
+			return err
+		}
+		if production {
+			return fmt.Errorf("changing %s requires replacing a resource in production environment %q, set allow_production_destroy on the resource or in the provider configuration to allow this", strings.Join(replacedBy, ", "), env)
+		}
+		return nil
+	}
+}
+
+// priorValues reads the values of a resource before the planned change.
+type priorValues struct {
+	d *schema.ResourceDiff
+}
+
+func (p priorValues) Get(key string) interface{} {
+	old, _ := p.d.GetChange(key)
+	return old
+}
+
+// replacedAttributes returns the changed top-level attributes that force the resource to be replaced.
+func replacedAttributes(d *schema.ResourceDiff, resourceSchema map[string]*schema.Schema) []string {
+	var result []string
+	for key, attribute := range resourceSchema {
+		if attribute.ForceNew && d.HasChange(key) {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// checkProductionDestroy refuses to destroy a resource within a production environment, unless
+// allow_production_destroy is set on the resource or the provider.
+func checkProductionDestroy(ctx context.Context, d *schema.ResourceData, provider ConfiguredProvider, org, env, resourceName string) error {
+	if provider.AllowProductionDestroy || d.Get("allow_production_destroy").(bool) {
+		return nil
+	}
+	production, err := isProductionEnvironment(ctx, provider.Client, org, env)
+	if err != nil {
+		return err
+	}
+	if production {
+		return fmt.Errorf("%s belongs to production environment %q and cannot be destroyed. To destroy it in this run, set allow_production_destroy in the provider configuration or ACLOUD_ALLOW_PRODUCTION_DESTROY=true. Setting allow_production_destroy on the resource only takes effect once it has been applied", resourceName, env)
+	}
+	return nil
+}
+
+func isProductionEnvironment(ctx context.Context, client acloudapi.Client, org, env string) (bool, error) {
+	environment, err := client.GetEnvironment(ctx, org, env)
+	if err != nil {
+		return false, fmt.Errorf("failed to get environment %q: %w", env, err)
+	}
+	if environment == nil {
+		return false, nil
+	}
+	return environment.Type == productionEnvironmentType, nil
+}
@@ -0,0 +1,197 @@
+package acloud
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultAPIEndpoint = "https://api.avisi.cloud"
+
+// configFile is the shared configuration file of the Avisi Cloud tooling, such as:
+//
+//	profiles:
+//	  production:
+//	    api: https://api.avisi.cloud
+//	    organisation: my-organisation
+//	    token_file: ~/.config/acloud/production-token
+type configFile struct {
+	Profiles map[string]configProfile `yaml:"profiles"`
+}
+
+type configProfile struct {
+	Token        string `yaml:"token"`
+	TokenFile    string `yaml:"token_file"`
+	API          string `yaml:"api"`
+	Organisation string `yaml:"organisation"`
+}
+
+// providerSettings are the provider settings that can be loaded from a profile.
+type providerSettings struct {
+	Token        string
+	TokenFile    string
+	APIEndpoint  string
+	Organisation string
+}
+
+func defaultConfigFilePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(configDir, "acloud", "config.yaml")
+}
+
+func loadConfigProfile(path, name string) (*configProfile, error) {
+	if path == "" {
+		return nil, errors.New("config_file is not set and the default location cannot be determined")
+	}
+	content, err := os.ReadFile(expandHomeDir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config configFile
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %q: %w", path, err)
+	}
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q was not found in config file %q", name, path)
+	}
+	return &profile, nil
+}
+
+func expandHomeDir(path string) string {
+	if len(path) < 2 || path[:2] != "~/" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
+
+// settingSource provides values for provider settings, keyed by attribute name.
+type settingSource struct {
+	name   string
+	values map[string]string
+	// envVars maps attributes to the environment variables providing them, for sources reading the environment
+	envVars map[string]string
+}
+
+func (s settingSource) describe(attribute string) string {
+	if envVar, ok := s.envVars[attribute]; ok {
+		return fmt.Sprintf("environment variable %s", envVar)
+	}
+	return fmt.Sprintf("%s from %s", attribute, s.name)
+}
+
+var providerSettingEnvVars = map[string]string{
+	"token":        "ACLOUD_PERSONAL_ACCESS_TOKEN",
+	"token_file":   "ACLOUD_TOKEN_FILE",
+	"acloud_api":   "ACLOUD_API_ENDPOINT",
+	"organisation": "ACLOUD_ORGANISATION",
+}
+
+// getProviderSettings resolves the settings of the provider. Attributes in the provider block take
+// precedence over the selected profile, which takes precedence over environment variables.
+func getProviderSettings(d *schema.ResourceData) (providerSettings, diag.Diagnostics) {
+	configured := map[string]string{}
+	if config := d.GetRawConfig(); !config.IsNull() {
+		for attribute := range providerSettingEnvVars {
+			if !config.GetAttr(attribute).IsNull() {
+				configured[attribute] = d.Get(attribute).(string)
+			}
+		}
+	}
+	sources := []settingSource{{name: "the provider configuration", values: configured}}
+
+	if name := d.Get("profile").(string); name != "" {
+		path := d.Get("config_file").(string)
+		if path == "" {
+			path = defaultConfigFilePath()
+		}
+		profile, err := loadConfigProfile(path, name)
+		if err != nil {
+			return providerSettings{}, diag.FromErr(err)
+		}
+		sources = append(sources, settingSource{
+			name: fmt.Sprintf("profile %q in %s", name, path),
+			values: map[string]string{
+				"token":        profile.Token,
+				"token_file":   expandHomeDir(profile.TokenFile),
+				"acloud_api":   profile.API,
+				"organisation": profile.Organisation,
+			},
+		})
+	}
+
+	env := map[string]string{}
+	for attribute, envVar := range providerSettingEnvVars {
+		env[attribute] = os.Getenv(envVar)
+	}
+	sources = append(sources, settingSource{name: "the environment", values: env, envVars: providerSettingEnvVars})
+
+	return resolveProviderSettings(sources)
+}
+
+// resolveProviderSettings takes every setting from the first source providing it. The token and token_file
+// are resolved together, so credentials from different sources are never combined. Values that are ignored
+// are reported as warnings, except environment variables overridden by the same attribute in the provider
+// configuration, as those only act as defaults for the attribute.
+func resolveProviderSettings(sources []settingSource) (providerSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resolve := func(attributes ...string) map[string]string {
+		winner := slices.IndexFunc(sources, func(source settingSource) bool {
+			return slices.ContainsFunc(attributes, func(attribute string) bool {
+				return source.values[attribute] != ""
+			})
+		})
+		if winner == -1 {
+			return map[string]string{}
+		}
+
+		resolved := sources[winner].values
+		for _, attribute := range attributes {
+			if resolved[attribute] != "" {
+				log.Printf("[INFO] acloud: using %s", sources[winner].describe(attribute))
+			}
+		}
+		for _, source := range sources[winner+1:] {
+			for _, attribute := range attributes {
+				if source.values[attribute] == "" || source.values[attribute] == resolved[attribute] {
+					continue
+				}
+				if winner == 0 && source.envVars != nil && resolved[attribute] != "" {
+					continue
+				}
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Ignoring %s", source.describe(attribute)),
+					Detail:   fmt.Sprintf("%s takes precedence over %s. Remove one of them to avoid ambiguity.", sources[winner].name, source.describe(attribute)),
+				})
+			}
+		}
+		return resolved
+	}
+
+	credentials := resolve("token", "token_file")
+	settings := providerSettings{
+		Token:        credentials["token"],
+		TokenFile:    credentials["token_file"],
+		APIEndpoint:  resolve("acloud_api")["acloud_api"],
+		Organisation: resolve("organisation")["organisation"],
+	}
+	if settings.APIEndpoint == "" {
+		settings.APIEndpoint = defaultAPIEndpoint
+	}
+	return settings, diags
+}
@@ -0,0 +1,115 @@
+package acloud
+
+import (
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestResolveProviderSettings(t *testing.T) {
+	config := func(values map[string]string) settingSource {
+		return settingSource{name: "the provider configuration", values: values}
+	}
+	profile := func(values map[string]string) settingSource {
+		return settingSource{name: `profile "production" in config.yaml`, values: values}
+	}
+	env := func(values map[string]string) settingSource {
+		return settingSource{name: "the environment", values: values, envVars: providerSettingEnvVars}
+	}
+
+	tests := []struct {
+		name         string
+		sources      []settingSource
+		want         providerSettings
+		wantWarnings []string
+	}{
+		{
+			name:    "defaults",
+			sources: []settingSource{config(nil), env(nil)},
+			want:    providerSettings{APIEndpoint: defaultAPIEndpoint},
+		},
+		{
+			name: "environment variables without profile",
+			sources: []settingSource{
+				config(nil),
+				env(map[string]string{"token": "env-token", "organisation": "env-org", "acloud_api": "https://env.example"}),
+			},
+			want: providerSettings{Token: "env-token", Organisation: "env-org", APIEndpoint: "https://env.example"},
+		},
+		{
+			name: "configuration overrides environment variables of the same attribute silently",
+			sources: []settingSource{
+				config(map[string]string{"token": "config-token", "organisation": "config-org"}),
+				env(map[string]string{"token": "env-token", "organisation": "env-org"}),
+			},
+			want: providerSettings{Token: "config-token", Organisation: "config-org", APIEndpoint: defaultAPIEndpoint},
+		},
+		{
+			name: "profile overrides environment variables",
+			sources: []settingSource{
+				config(nil),
+				profile(map[string]string{"token": "profile-token", "organisation": "profile-org"}),
+				env(map[string]string{"token": "env-token", "organisation": "env-org", "acloud_api": "https://env.example"}),
+			},
+			want: providerSettings{Token: "profile-token", Organisation: "profile-org", APIEndpoint: "https://env.example"},
+			wantWarnings: []string{
+				"Ignoring environment variable ACLOUD_PERSONAL_ACCESS_TOKEN",
+				"Ignoring environment variable ACLOUD_ORGANISATION",
+			},
+		},
+		{
+			name: "profile token file replaces token from environment variable",
+			sources: []settingSource{
+				config(nil),
+				profile(map[string]string{"token_file": "/tokens/production"}),
+				env(map[string]string{"token": "env-token"}),
+			},
+			want:         providerSettings{TokenFile: "/tokens/production", APIEndpoint: defaultAPIEndpoint},
+			wantWarnings: []string{"Ignoring environment variable ACLOUD_PERSONAL_ACCESS_TOKEN"},
+		},
+		{
+			name: "configured token replaces profile token file",
+			sources: []settingSource{
+				config(map[string]string{"token": "config-token"}),
+				profile(map[string]string{"token_file": "/tokens/production", "organisation": "profile-org"}),
+				env(nil),
+			},
+			want:         providerSettings{Token: "config-token", Organisation: "profile-org", APIEndpoint: defaultAPIEndpoint},
+			wantWarnings: []string{`Ignoring token_file from profile "production" in config.yaml`},
+		},
+		{
+			name: "configured token file replaces token from environment variable",
+			sources: []settingSource{
+				config(map[string]string{"token_file": "/tokens/config"}),
+				env(map[string]string{"token": "env-token"}),
+			},
+			want:         providerSettings{TokenFile: "/tokens/config", APIEndpoint: defaultAPIEndpoint},
+			wantWarnings: []string{"Ignoring environment variable ACLOUD_PERSONAL_ACCESS_TOKEN"},
+		},
+		{
+			name: "equal values are not reported",
+			sources: []settingSource{
+				config(nil),
+				profile(map[string]string{"organisation": "my-org"}),
+				env(map[string]string{"organisation": "my-org"}),
+			},
+			want: providerSettings{Organisation: "my-org", APIEndpoint: defaultAPIEndpoint},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := resolveProviderSettings(tt.sources)
+			if got != tt.want {
+				t.Errorf("resolveProviderSettings() = %+v, want %+v", got, tt.want)
+			}
+			var warnings []string
+			for _, d := range diags {
+				warnings = append(warnings, d.Summary)
+			}
+			if !slices.Equal(warnings, tt.wantWarnings) {
+				t.Errorf("resolveProviderSettings() warnings = %q, want %q", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -4,43 +4,105 @@ import (
 	"context"
 	"crypto/sha1"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"golang.org/x/exp/slices"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_PERSONAL_ACCESS_TOKEN", nil),
+				Description: "Personal access token. Cannot be combined with token_file, client_credentials or oidc_token_exchange.",
+			},
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_TOKEN_FILE", nil),
+				Description: "Path of a file containing the token. The file is read again when it changes, so rotated tokens are picked up.",
 			},
+			"client_credentials":  clientCredentialsSchema(),
+			"oidc_token_exchange": oidcTokenExchangeSchema(),
 			"acloud_api": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_API_ENDPOINT", "https://api.avisi.cloud"),
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_API_ENDPOINT", defaultAPIEndpoint),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_PROFILE", nil),
+				Description: "Name of the profile in the config file to load the token, token_file, acloud_api and organisation from. Attributes take precedence over the profile, which takes precedence over environment variables. A token or token_file in the profile replaces both ACLOUD_PERSONAL_ACCESS_TOKEN and ACLOUD_TOKEN_FILE. Ignored values are reported as warnings.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_CONFIG_FILE", nil),
+				Description: "Path of the config file containing the profiles. Defaults to acloud/config.yaml in the user configuration directory, such as ~/.config/acloud/config.yaml.",
 			},
 			"organisation": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_ORGANISATION", ""),
 			},
+			"allow_production_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_ALLOW_PRODUCTION_DESTROY", false),
+				Description: "Allow destroying or replacing clusters, node pools and environments in production environments. Unlike allow_production_destroy on a resource, this also applies when destroying a resource in the same run.",
+			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_SKIP_CREDENTIALS_VALIDATION", false),
+				Description: "Skip verifying the token and organisation against the API when the provider is configured. Useful for offline runs and tokens that cannot list their organisations.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ACLOUD_READ_ONLY", false),
+				Description: "Only allow reading resources and data sources. Creating, updating and deleting resources fails.",
+			},
+			"allowed_organisations": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Slugs of the organisations the provider may manage. Any organisation is allowed when not set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_environments": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Slugs of the environments the provider may manage. Any environment is allowed when not set. Data sources listing environments or clusters only return the allowed environments. New environments must have a name that results in an allowed slug.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"acloud_environment":          resourceEnvironment(),
 			"acloud_cluster":              resourceCluster(),
 			"acloud_nodepool":             resourceNodepool(),
 			"acloud_cloud_account":        resourceCloudAccount(),
+			"acloud_cloud_credentials":    resourceCloudCredentials(),
 			"acloud_maintenance_schedule": resourceMaintenanceSchedule(),
+			"acloud_maintenance_freeze":   resourceMaintenanceFreeze(),
+			"acloud_team":                 resourceTeam(),
+			"acloud_cluster_access":       resourceClusterAccess(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"acloud_cloud_profile":                     dataSourceCloudProfile(),
+			"acloud_cloud_profiles":                    dataSourceCloudProfiles(),
 			"acloud_cloud_account":                     dataSourceCloudAccount(),
 			"acloud_cloud_accounts":                    dataSourceCloudAccounts(),
 			"acloud_cloud_provider_availability_zones": dataSourceCloudProviderAvailabilityZones(),
@@ -48,46 +110,158 @@ func Provider() *schema.Provider {
 			"acloud_cloud_provider_regions":            dataSourceCloudProviderRegions(),
 			"acloud_cloud_providers":                   dataSourceCloudProviders(),
 			"acloud_cluster":                           dataSourceCluster(),
+			"acloud_clusters":                          dataSourceClusters(),
 			"acloud_nodepool":                          dataSourceNodepool(),
+			"acloud_nodepools":                         dataSourceNodepools(),
 			"acloud_environment":                       dataSourceEnvironment(),
+			"acloud_environments":                      dataSourceEnvironments(),
 			"acloud_nodepool_join_config":              dataSourceNodeJoinConfig(),
 			"acloud_organisation":                      dataSourceOrganisations(),
+			"acloud_organisations":                     dataSourceOrganisationMemberships(),
+			"acloud_teams":                             dataSourceTeams(),
 			"acloud_update_channel":                    dataSourceUpdateChannel(),
 			"acloud_maintenance_schedule":              dataSourceMaintenanceSchedule(),
+			"acloud_maintenance_schedules":             dataSourceMaintenanceSchedules(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for name, resource := range p.ResourcesMap {
+		rejectChangesInReadOnlyMode(name, resource)
+	}
+	return p
+}
+
+// rejectChangesInReadOnlyMode wraps the create, update and delete functions of a resource,
+// failing them when the provider is configured with read_only.
+func rejectChangesInReadOnlyMode(name string, resource *schema.Resource) {
+	resource.CreateContext = readOnlyGuard(name, "create", resource.CreateContext)
+	resource.UpdateContext = readOnlyGuard(name, "update", resource.UpdateContext)
+	resource.DeleteContext = readOnlyGuard(name, "delete", resource.DeleteContext)
+}
+
+type resourceContextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func readOnlyGuard(name, action string, f resourceContextFunc) resourceContextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if getProvider(m).ReadOnly {
+			target := name
+			if d.Id() != "" {
+				target = fmt.Sprintf("%s %q", name, d.Id())
+			}
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Provider is in read-only mode",
+				Detail:   fmt.Sprintf("Cannot %s %s: the provider is configured with read_only, which only allows reading resources and data sources.", action, target),
+			}}
+		}
+		return f(ctx, d, m)
+	}
 }
 
 type ConfiguredProvider struct {
-	Client       acloudapi.Client
-	Organisation string
+	Client                 acloudapi.Client
+	Organisation           string
+	AllowProductionDestroy bool
+	AllowedOrganisations   []string
+	AllowedEnvironments    []string
+	ReadOnly               bool
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	token := d.Get("token").(string)
-	acloudApiEndpoint := d.Get("acloud_api").(string)
-	organisation := d.Get("organisation").(string)
+	settings, diags := getProviderSettings(d)
+	if diags.HasError() {
+		return nil, diags
+	}
+	acloudApiEndpoint := settings.APIEndpoint
+	organisation := settings.Organisation
 
-	authenticator := acloudapi.NewPersonalAccessTokenAuthenticator(token)
+	authenticator, err := getAuthenticator(d, settings)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	clientOpts := acloudapi.ClientOpts{
 		APIUrl: acloudApiEndpoint,
 	}
 
 	c := acloudapi.NewClient(authenticator, clientOpts)
-	if token != "" {
-		c.Resty().OnBeforeRequest(authenticator.Authenticate)
-	}
 
 	p := ConfiguredProvider{
-		Client:       c,
-		Organisation: organisation,
+		Client:                 c,
+		Organisation:           organisation,
+		AllowProductionDestroy: d.Get("allow_production_destroy").(bool),
+		AllowedOrganisations:   castStringSet(d.Get("allowed_organisations").(*schema.Set)),
+		AllowedEnvironments:    castStringSet(d.Get("allowed_environments").(*schema.Set)),
+		ReadOnly:               d.Get("read_only").(bool),
+	}
+
+	if !d.Get("skip_credentials_validation").(bool) {
+		diags = append(diags, validateCredentials(ctx, c, acloudApiEndpoint, organisation)...)
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
-	return p, nil
+	return p, diags
+}
+
+// validateCredentials verifies the token by listing its memberships, and checks the configured
+// organisation is one of them, so configuration mistakes surface before any resource is touched.
+func validateCredentials(ctx context.Context, client acloudapi.Client, endpoint, organisation string) diag.Diagnostics {
+	memberships, err := client.GetMemberships(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to verify Avisi Cloud credentials",
+			Detail:   fmt.Sprintf("Listing the organisations of the token at %s failed: %s. Check the token and acloud_api settings, or set skip_credentials_validation for offline runs and tokens that cannot list their organisations.", endpoint, err),
+		}}
+	}
+	if organisation == "" {
+		return nil
+	}
+
+	slugs := make([]string, len(memberships))
+	for i, membership := range memberships {
+		if membership.Slug == organisation {
+			return nil
+		}
+		slugs[i] = membership.Slug
+	}
+	if len(slugs) == 0 {
+		slugs = append(slugs, "none")
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Organisation not found",
+		Detail:   fmt.Sprintf("The token is not a member of organisation %q. Organisations available to the token: %s.", organisation, strings.Join(slugs, ", ")),
+	}}
 }
 
 func setAsID(d *schema.ResourceData, customID string) {
 	computedId := sha1.Sum([]byte(customID))
 	d.SetId(fmt.Sprintf("%x", computedId))
 }
+
+// checkAllowedOrganisation refuses organisations that are not in the allowed_organisations of the provider.
+func checkAllowedOrganisation(provider ConfiguredProvider, org string) error {
+	if len(provider.AllowedOrganisations) == 0 || slices.Contains(provider.AllowedOrganisations, org) {
+		return nil
+	}
+	return fmt.Errorf("organisation %q is not allowed by the provider, allowed organisations: %s", org, strings.Join(provider.AllowedOrganisations, ", "))
+}
+
+// checkAllowedEnvironment refuses environments that are not in the allowed_environments of the provider.
+func checkAllowedEnvironment(provider ConfiguredProvider, env string) error {
+	if isAllowedEnvironment(provider, env) {
+		return nil
+	}
+	return fmt.Errorf("environment %q is not allowed by the provider, allowed environments: %s", env, strings.Join(provider.AllowedEnvironments, ", "))
+}
+
+// isAllowedEnvironment reports whether the environment is in the allowed_environments of the provider.
+func isAllowedEnvironment(provider ConfiguredProvider, env string) bool {
+	return len(provider.AllowedEnvironments) == 0 || slices.Contains(provider.AllowedEnvironments, env)
+}
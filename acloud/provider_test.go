@@ -0,0 +1,61 @@
+package acloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+)
+
+// unusedClient fails every call, as the embedded client is nil.
+type unusedClient struct {
+	acloudapi.Client
+}
+
+func TestReadOnlyGuard(t *testing.T) {
+	provider := ConfiguredProvider{Client: unusedClient{}, ReadOnly: true}
+
+	for name, resource := range Provider().ResourcesMap {
+		t.Run(name, func(t *testing.T) {
+			// only the context functions are wrapped, so the other variants must not be used
+			if resource.Create != nil || resource.Update != nil || resource.Delete != nil ||
+				resource.CreateWithoutTimeout != nil || resource.UpdateWithoutTimeout != nil || resource.DeleteWithoutTimeout != nil {
+				t.Fatal("resource uses create, update or delete functions that are not guarded by read_only")
+			}
+			if resource.CreateContext == nil || resource.DeleteContext == nil {
+				t.Fatal("resource has no create or delete function")
+			}
+
+			actions := map[string]func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics{
+				"create": resource.CreateContext,
+				"update": resource.UpdateContext,
+				"delete": resource.DeleteContext,
+			}
+			for action, f := range actions {
+				if f == nil {
+					continue
+				}
+				t.Run(action, func(t *testing.T) {
+					defer func() {
+						if r := recover(); r != nil {
+							t.Fatalf("%s reached the client in read-only mode: %v", action, r)
+						}
+					}()
+
+					d := resource.TestResourceData()
+					if action != "create" {
+						d.SetId("test")
+					}
+					diags := f(context.Background(), d, provider)
+					if !diags.HasError() || !strings.Contains(diags[0].Summary, "read-only") {
+						t.Errorf("%s returned %v, want the read-only error", action, diags)
+					}
+				})
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"golang.org/x/exp/slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +19,7 @@ func resourceCloudAccount() *schema.Resource {
 		ReadContext:   resourceCloudAccountRead,
 		UpdateContext: resourceCloudAccountUpdate,
 		DeleteContext: resourceCloudAccountDelete,
+		CustomizeDiff: customizeCloudAccountDiff,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -67,25 +69,72 @@ func resourceCloudAccount() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the cloud account even when clusters still use it. By default deletion is refused and the clusters using the cloud account are listed.",
+			},
 
 			// metadata fields
+			"vsphere": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"vsphere_parent_folder", "vsphere_parent_resource_pool"},
+				Description:   "vSphere specific settings. Can only be set when the cloud profile uses vSphere.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parent_folder": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "vSphere parent folder",
+						},
+						"parent_resource_pool": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "vSphere parent resource pool",
+						},
+					},
+				},
+			},
+			"openstack": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"openstack_tenant_id"},
+				Description:   "OpenStack specific settings. Can only be set when the cloud profile uses OpenStack.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "OpenStack tenant ID. Can only be set on creation.",
+						},
+					},
+				},
+			},
 			"vsphere_parent_folder": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "vSphere parent folder",
+				Deprecated:  "replaced by vsphere.parent_folder",
 			},
 			"vsphere_parent_resource_pool": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "vSphere parent resource pool",
+				Deprecated:  "replaced by vsphere.parent_resource_pool",
 			},
 			"openstack_tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
 				Description: "OpenStack tenant ID",
+				Deprecated:  "replaced by openstack.tenant_id",
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -101,6 +150,141 @@ func nilOrString(s string) *string {
 	return &s
 }
 
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// cloudAccountMetadataBlocks maps the provider specific metadata blocks, and their legacy attributes,
+// to the cloud provider the cloud profile must use.
+var cloudAccountMetadataBlocks = map[string][]string{
+	"vsphere":   {"vsphere_parent_folder", "vsphere_parent_resource_pool"},
+	"openstack": {"openstack_tenant_id"},
+}
+
+func customizeCloudAccountDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	var configured []string
+	for block, legacyAttributes := range cloudAccountMetadataBlocks {
+		if isCloudAccountMetadataConfigured(d, block, legacyAttributes) {
+			configured = append(configured, block)
+			continue
+		}
+		// the blocks are computed to keep them in sync with the deprecated attributes, so clear them explicitly
+		// when they are removed from the configuration
+		if d.Id() != "" && len(d.Get(block).([]interface{})) > 0 {
+			if err := d.SetNew(block, []interface{}{}); err != nil {
+				return err
+			}
+			if block == "openstack" {
+				if err := d.ForceNew(block); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(configured) == 0 || !d.NewValueKnown("cloud_profile_identity") {
+		return nil
+	}
+	if len(configured) > 1 {
+		slices.Sort(configured)
+		return fmt.Errorf("only one of %s can be configured on a cloud account", strings.Join(configured, ", "))
+	}
+
+	provider := getProvider(m)
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return err
+	}
+	cloudProfiles, err := provider.Client.GetCloudProfiles(ctx, org)
+	if err != nil {
+		return fmt.Errorf("failed to get cloud profiles for organisation %q: %w", org, err)
+	}
+	cloudProfile, err := findCloudProfile(cloudProfiles, d.Get("cloud_profile_identity").(string), "", "")
+	if err != nil {
+		return err
+	}
+	if !cloudProfileUsesProvider(*cloudProfile, configured[0]) {
+		return fmt.Errorf("%s settings cannot be used with cloud profile %q, which uses cloud provider %q", configured[0], cloudProfile.DisplayName, cloudProfile.CloudProvider)
+	}
+	return nil
+}
+
+// isCloudAccountMetadataConfigured reports whether the block or its deprecated attributes are in the configuration.
+// The blocks are computed, so their value in the plan cannot tell a configured block from one read back from the API.
+func isCloudAccountMetadataConfigured(d *schema.ResourceDiff, block string, legacyAttributes []string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return false
+	}
+	if value := config.GetAttr(block); !value.IsKnown() || (!value.IsNull() && value.LengthInt() > 0) {
+		return true
+	}
+	for _, attribute := range legacyAttributes {
+		if value := config.GetAttr(attribute); !value.IsKnown() || (!value.IsNull() && value.AsString() != "") {
+			return true
+		}
+	}
+	return false
+}
+
+// cloudProfileUsesProvider reports whether a cloud profile is backed by the given provider,
+// either directly or through its type, as providers such as OpenStack are offered by several clouds.
+func cloudProfileUsesProvider(cloudProfile acloudapi.CloudProfile, provider string) bool {
+	return strings.EqualFold(cloudProfile.CloudProvider, provider) || strings.EqualFold(cloudProfile.Type, provider)
+}
+
+func expandCloudAccountMetadata(d *schema.ResourceData) acloudapi.CloudAccountMetadata {
+	// the deprecated attributes are only in state when they are configured, so they take precedence
+	return acloudapi.CloudAccountMetadata{
+		VsphereParentFolder:       nilOrString(getStringAttributeWithLegacyName(d, "vsphere.0.parent_folder", "vsphere_parent_folder")),
+		VSphereParentResourcePool: nilOrString(getStringAttributeWithLegacyName(d, "vsphere.0.parent_resource_pool", "vsphere_parent_resource_pool")),
+		OpenStackTenantID:         nilOrString(getStringAttributeWithLegacyName(d, "openstack.0.tenant_id", "openstack_tenant_id")),
+	}
+}
+
+func flattenCloudAccountMetadata(metadata acloudapi.CloudAccountMetadata) (vsphere []interface{}, openstack []interface{}) {
+	// the API returns empty values for settings that do not apply to the cloud provider
+	vsphere = []interface{}{}
+	if stringOrEmpty(metadata.VsphereParentFolder) != "" || stringOrEmpty(metadata.VSphereParentResourcePool) != "" {
+		vsphere = append(vsphere, map[string]interface{}{
+			"parent_folder":        stringOrEmpty(metadata.VsphereParentFolder),
+			"parent_resource_pool": stringOrEmpty(metadata.VSphereParentResourcePool),
+		})
+	}
+	openstack = []interface{}{}
+	if stringOrEmpty(metadata.OpenStackTenantID) != "" {
+		openstack = append(openstack, map[string]interface{}{
+			"tenant_id": stringOrEmpty(metadata.OpenStackTenantID),
+		})
+	}
+	return vsphere, openstack
+}
+
+func setCloudAccountAttributes(d *schema.ResourceData, cloudAccount acloudapi.CloudAccount) {
+	d.SetId(cloudAccount.Identity)
+	d.Set("identity", cloudAccount.Identity)
+	d.Set("display_name", cloudAccount.DisplayName)
+	d.Set("enabled", cloudAccount.Enabled)
+	d.Set("primary_cloud_credentials_identity", cloudAccount.PrimaryCloudCredentialsIdentity)
+	d.Set("cloud_profile_identity", cloudAccount.CloudProfile.Identity)
+	d.Set("cloud_profile_cloud_provider", cloudAccount.CloudProfile.CloudProvider)
+	d.Set("regions", cloudAccount.CloudProfile.Regions)
+
+	vsphere, openstack := flattenCloudAccountMetadata(cloudAccount.Metadata)
+	d.Set("vsphere", vsphere)
+	d.Set("openstack", openstack)
+	// only keep the deprecated attributes in state for configurations that still use them
+	if d.Get("vsphere_parent_folder").(string) != "" || d.Get("vsphere_parent_resource_pool").(string) != "" {
+		d.Set("vsphere_parent_folder", cloudAccount.Metadata.VsphereParentFolder)
+		d.Set("vsphere_parent_resource_pool", cloudAccount.Metadata.VSphereParentResourcePool)
+	}
+	if d.Get("openstack_tenant_id").(string) != "" {
+		d.Set("openstack_tenant_id", cloudAccount.Metadata.OpenStackTenantID)
+	}
+}
+
 func resourceCloudAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	provider := getProvider(m)
 	client := provider.Client
@@ -109,18 +293,10 @@ func resourceCloudAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	vsphereParentFolder := d.Get("vsphere_parent_folder").(string)
-	vSphereParentResourcePool := d.Get("vsphere_parent_resource_pool").(string)
-	openStackTenantID := d.Get("openstack_tenant_id").(string)
-
 	createCloudAccount := acloudapi.CreateCloudAccount{
 		DisplayName:  d.Get("display_name").(string),
 		CloudProfile: d.Get("cloud_profile_identity").(string),
-		Metadata: acloudapi.CloudAccountMetadata{
-			VsphereParentFolder:       nilOrString(vsphereParentFolder),
-			VSphereParentResourcePool: nilOrString(vSphereParentResourcePool),
-			OpenStackTenantID:         nilOrString(openStackTenantID),
-		},
+		Metadata:     expandCloudAccountMetadata(d),
 	}
 
 	cloudAccount, err := client.CreateCloudAccount(ctx, org, createCloudAccount)
@@ -128,17 +304,7 @@ func resourceCloudAccountCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 	if cloudAccount != nil {
-		d.SetId(cloudAccount.Identity)
-		d.Set("identity", cloudAccount.Identity)
-		d.Set("display_name", cloudAccount.DisplayName)
-		d.Set("enabled", cloudAccount.Enabled)
-		d.Set("primary_cloud_credentials_identity", cloudAccount.PrimaryCloudCredentialsIdentity)
-		d.Set("vsphere_parent_folder", cloudAccount.Metadata.VsphereParentFolder)
-		d.Set("vsphere_parent_resource_pool", cloudAccount.Metadata.VSphereParentResourcePool)
-		d.Set("openstack_tenant_id", cloudAccount.Metadata.OpenStackTenantID)
-		d.Set("cloud_profile_identity", cloudAccount.CloudProfile.Identity)
-		d.Set("cloud_profile_cloud_provider", cloudAccount.CloudProfile.CloudProvider)
-		d.Set("regions", cloudAccount.CloudProfile.Regions)
+		setCloudAccountAttributes(d, *cloudAccount)
 		return nil
 	}
 	return resourceCloudAccountRead(ctx, d, m)
@@ -153,36 +319,20 @@ func resourceCloudAccountRead(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	identity := d.Get("id").(string)
-	cloudAccounts, err := client.GetCloudAccounts(ctx, org)
+	cloudAccount, err := client.GetCloudAccount(ctx, org, identity)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	if len(cloudAccounts) == 0 {
-		return diag.FromErr(fmt.Errorf("cloud account was not found: empty list"))
-	}
-
-	var cloudAccount acloudapi.CloudAccount
-	for _, item := range cloudAccounts {
-		if item.Identity == identity {
-			cloudAccount = item
-			break
-		}
+		return diag.FromErr(fmt.Errorf("failed to get cloud account %q: %w", identity, err))
 	}
-	if cloudAccount.Identity == "" {
-		return diag.FromErr(fmt.Errorf("cloud account was not found: no identity: %v", cloudAccounts))
+	if cloudAccount == nil {
+		d.SetId("")
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Cloud account was not found",
+			Detail:   fmt.Sprintf("Cloud account %q no longer exists in organisation %q and has been removed from the state.", identity, org),
+		}}
 	}
 
-	d.SetId(cloudAccount.Identity)
-	d.Set("identity", cloudAccount.Identity)
-	d.Set("display_name", cloudAccount.DisplayName)
-	d.Set("enabled", cloudAccount.Enabled)
-	d.Set("primary_cloud_credentials_identity", cloudAccount.PrimaryCloudCredentialsIdentity)
-	d.Set("vsphere_parent_folder", cloudAccount.Metadata.VsphereParentFolder)
-	d.Set("vsphere_parent_resource_pool", cloudAccount.Metadata.VSphereParentResourcePool)
-	d.Set("openstack_tenant_id", cloudAccount.Metadata.OpenStackTenantID)
-	d.Set("cloud_profile_identity", cloudAccount.CloudProfile.Identity)
-	d.Set("cloud_profile_cloud_provider", cloudAccount.CloudProfile.CloudProvider)
-	d.Set("regions", cloudAccount.CloudProfile.Regions)
+	setCloudAccountAttributes(d, *cloudAccount)
 
 	return nil
 }
@@ -199,6 +349,13 @@ func resourceCloudAccountUpdate(ctx context.Context, d *schema.ResourceData, m i
 		DisplayName: d.Get("display_name").(string),
 		Enabled:     d.Get("enabled").(bool),
 	}
+	if d.HasChanges("vsphere", "vsphere_parent_folder", "vsphere_parent_resource_pool") {
+		metadata := expandCloudAccountMetadata(d)
+		// send empty values, as leaving them out keeps the settings of a removed vsphere block
+		metadata.VsphereParentFolder = ToPtr(stringOrEmpty(metadata.VsphereParentFolder))
+		metadata.VSphereParentResourcePool = ToPtr(stringOrEmpty(metadata.VSphereParentResourcePool))
+		updateCloudAccount.Metadata = &metadata
+	}
 	cloudAccount, err := client.UpdateCloudAccount(ctx, org, identity, updateCloudAccount)
 	if err != nil {
 		return diag.FromErr(err)
@@ -219,12 +376,40 @@ func resourceCloudAccountDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	identity := d.Get("id").(string)
 
-	error := client.DeleteCloudAccount(ctx, org, identity)
-	if error != nil {
-		return diag.FromErr(error)
+	if !d.Get("force_destroy").(bool) {
+		clusters, err := getCloudAccountClusters(ctx, client, org, identity)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(clusters) > 0 {
+			return diag.FromErr(fmt.Errorf("cloud account %q is still used by clusters %s, delete these clusters first or set force_destroy to delete the cloud account anyway", identity, strings.Join(clusters, ", ")))
+		}
+	}
+
+	err = client.DeleteCloudAccount(ctx, org, identity)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete cloud account %q: %w", identity, err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// getCloudAccountClusters returns the clusters using the cloud account, formatted as environment/cluster.
+func getCloudAccountClusters(ctx context.Context, client acloudapi.Client, org, identity string) ([]string, error) {
+	clusters, err := client.GetClusters(ctx, acloudapi.ListClusterOpts{
+		OrganisationSlug: org,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clusters for organisation %q: %w", org, err)
+	}
+
+	var result []string
+	for _, cluster := range clusters {
+		if cluster.CloudAccount.Identity == identity {
+			result = append(result, fmt.Sprintf("%s/%s", cluster.EnvironmentSlug, cluster.Slug))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create and rotate cloud credentials for a cloud account. Secrets are write-only and are never stored in the Terraform state.",
+		CreateContext: resourceCloudCredentialsCreate,
+		ReadContext:   resourceCloudCredentialsRead,
+		UpdateContext: resourceCloudCredentialsUpdate,
+		DeleteContext: resourceCloudCredentialsDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Slug of the Organisation. Can only be set on creation.",
+			},
+			"cloud_account_identity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identity of the cloud account. Can only be set on creation.",
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the cloud credentials",
+			},
+			"primary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use these cloud credentials as the primary credentials of the cloud account",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last change to the cloud credentials, including rotations",
+			},
+
+			// metadata fields
+			"aws_role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ARN of the AWS IAM role that is assumed",
+			},
+			"azure_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client ID of the Azure service principal",
+			},
+			"azure_tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tenant ID of the Azure service principal",
+			},
+			"azure_subscription_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure subscription ID",
+			},
+			"openstack_application_credential_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the OpenStack application credential",
+			},
+			"vsphere_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "vSphere username",
+			},
+
+			// write-only secret fields
+			"aws_external_id_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "External ID used when assuming the AWS IAM role. Write-only, never stored in state.",
+			},
+			"azure_client_secret_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Client secret of the Azure service principal. Write-only, never stored in state.",
+			},
+			"openstack_application_credential_secret_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Secret of the OpenStack application credential. Write-only, never stored in state.",
+			},
+			"vsphere_password_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "vSphere password. Write-only, never stored in state.",
+			},
+			"secrets_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of the write-only secrets. Terraform cannot detect changes to write-only attributes, so change this value to rotate the secrets.",
+			},
+		},
+	}
+}
+
+// getWriteOnlyString returns the value of a write-only attribute. Write-only attributes are only
+// available in the configuration during apply, d.Get always returns the zero value for them.
+func getWriteOnlyString(d *schema.ResourceData, attribute string) *string {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	raw := config.GetAttr(attribute)
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	return nilOrString(raw.AsString())
+}
+
+func expandCloudCredentialsMetadata(d *schema.ResourceData) acloudapi.CloudCredentialsMetadata {
+	return acloudapi.CloudCredentialsMetadata{
+		AWSRoleARN:                       nilOrString(d.Get("aws_role_arn").(string)),
+		AzureClientID:                    nilOrString(d.Get("azure_client_id").(string)),
+		AzureTenantID:                    nilOrString(d.Get("azure_tenant_id").(string)),
+		AzureSubscriptionID:              nilOrString(d.Get("azure_subscription_id").(string)),
+		OpenStackApplicationCredentialID: nilOrString(d.Get("openstack_application_credential_id").(string)),
+		VSphereUsername:                  nilOrString(d.Get("vsphere_username").(string)),
+	}
+}
+
+func expandCloudCredentialsSecrets(d *schema.ResourceData) acloudapi.CloudCredentialsSecrets {
+	return acloudapi.CloudCredentialsSecrets{
+		AWSExternalID:                        getWriteOnlyString(d, "aws_external_id_wo"),
+		AzureClientSecret:                    getWriteOnlyString(d, "azure_client_secret_wo"),
+		OpenStackApplicationCredentialSecret: getWriteOnlyString(d, "openstack_application_credential_secret_wo"),
+		VSpherePassword:                      getWriteOnlyString(d, "vsphere_password_wo"),
+	}
+}
+
+func resourceCloudCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountIdentity := d.Get("cloud_account_identity").(string)
+	cloudCredentials, err := client.CreateCloudCredentials(ctx, org, cloudAccountIdentity, acloudapi.CreateCloudCredentials{
+		DisplayName: d.Get("display_name").(string),
+		Primary:     d.Get("primary").(bool),
+		Metadata:    expandCloudCredentialsMetadata(d),
+		Secrets:     expandCloudCredentialsSecrets(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create cloud credentials for cloud account %q: %w", cloudAccountIdentity, err))
+	}
+
+	d.SetId(cloudCredentials.Identity)
+	return resourceCloudCredentialsRead(ctx, d, m)
+}
+
+func resourceCloudCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountIdentity := d.Get("cloud_account_identity").(string)
+	cloudCredentials, err := client.GetCloudCredentials(ctx, org, cloudAccountIdentity, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get cloud credentials for cloud account %q: %w", cloudAccountIdentity, err))
+	}
+	if cloudCredentials == nil {
+		return diag.FromErr(fmt.Errorf("cloud credentials %q were not found in cloud account %q", d.Id(), cloudAccountIdentity))
+	}
+
+	d.Set("display_name", cloudCredentials.DisplayName)
+	d.Set("primary", cloudCredentials.Primary)
+	d.Set("updated_at", cloudCredentials.UpdatedAt.Format(time.RFC3339))
+	d.Set("aws_role_arn", cloudCredentials.Metadata.AWSRoleARN)
+	d.Set("azure_client_id", cloudCredentials.Metadata.AzureClientID)
+	d.Set("azure_tenant_id", cloudCredentials.Metadata.AzureTenantID)
+	d.Set("azure_subscription_id", cloudCredentials.Metadata.AzureSubscriptionID)
+	d.Set("openstack_application_credential_id", cloudCredentials.Metadata.OpenStackApplicationCredentialID)
+	d.Set("vsphere_username", cloudCredentials.Metadata.VSphereUsername)
+
+	return nil
+}
+
+func resourceCloudCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateCloudCredentials := acloudapi.UpdateCloudCredentials{
+		DisplayName: d.Get("display_name").(string),
+		Primary:     d.Get("primary").(bool),
+		Metadata:    expandCloudCredentialsMetadata(d),
+	}
+	// secrets are only sent, and thereby rotated, when their version changes
+	if d.HasChange("secrets_wo_version") {
+		secrets := expandCloudCredentialsSecrets(d)
+		updateCloudCredentials.Secrets = &secrets
+	}
+
+	cloudAccountIdentity := d.Get("cloud_account_identity").(string)
+	_, err = client.UpdateCloudCredentials(ctx, org, cloudAccountIdentity, d.Id(), updateCloudCredentials)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update cloud credentials for cloud account %q: %w", cloudAccountIdentity, err))
+	}
+
+	return resourceCloudCredentialsRead(ctx, d, m)
+}
+
+func resourceCloudCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountIdentity := d.Get("cloud_account_identity").(string)
+	err = client.DeleteCloudCredentials(ctx, org, cloudAccountIdentity, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete cloud credentials for cloud account %q: %w", cloudAccountIdentity, err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
@@ -20,13 +21,12 @@ const (
 )
 
 func resourceCluster() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description:   "Create an Avisi Cloud Kubernetes cluster within an environment",
 		CreateContext: resourceClusterCreate,
 		ReadContext:   resourceClusterRead,
 		UpdateContext: resourceClusterUpdate,
 		DeleteContext: resourceClusterDelete,
-		CustomizeDiff: customizeClusterAddonsDiff,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -101,7 +101,8 @@ func resourceCluster() *schema.Resource {
 			"update_channel": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Avisi Cloud Kubernetes Update Channel that the Cluster follows",
+				Computed:    true,
+				Description: "Avisi Cloud Kubernetes Update Channel that the Cluster follows. A new cluster inherits default_update_channel of the environment when not set.",
 			},
 			"addons": {
 				Type:        schema.TypeSet,
@@ -131,14 +132,14 @@ func resourceCluster() *schema.Resource {
 			"pod_security_standards_profile": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "PRIVILEGED",
-				Description: "Pod Security Standards used by default within the cluster",
+				Computed:    true,
+				Description: "Pod Security Standards used by default within the cluster. A new cluster inherits default_pod_security_standards_profile of the environment when not set, PRIVILEGED otherwise. Removing the attribute keeps the current profile of an existing cluster, it is no longer reset to PRIVILEGED.",
 			},
 			"delete_protection": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
-				Description: "Is delete protection enabled on the cluster",
+				Computed:    true,
+				Description: "Is delete protection enabled on the cluster. A new cluster inherits default_delete_protection of the environment when not set. Removing the attribute keeps the current setting of an existing cluster, it is no longer reset to false.",
 			},
 			"enable_multi_availability_zones": {
 				Type:        schema.TypeBool,
@@ -192,25 +193,189 @@ func resourceCluster() *schema.Resource {
 			"maintenance_schedule_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "ID of the maintenance schedule to apply to the cluster",
+				Computed:    true,
+				Description: "ID of the maintenance schedule to apply to the cluster. A new cluster inherits default_maintenance_schedule_id of the environment when not set.",
+			},
+			"next_maintenance_windows": nextMaintenanceWindowsSchema(),
+			"maintenance_freeze_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is a maintenance freeze currently active for the cluster",
+			},
+			"kured_follow_maintenance_schedule": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Derive the reboot window of the kured add-on from the maintenance schedule of the cluster. Overrides startTime, endTime, timeZone and rebootDays of the kured custom values.",
+			},
+			"kured_reboot_window_outside_schedule": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the customised reboot window of the kured add-on not covered by the maintenance schedule of the cluster. Planned from the configured add-ons, so a reboot window outside the schedule shows in the plan.",
 			},
+			"allow_production_destroy": allowProductionDestroySchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
+	resource.CustomizeDiff = customdiff.All(
+		customizeClusterMaintenanceWindowsDiff,
+		customizeClusterAddonsDiff,
+		customizeProductionReplacementDiff(resource.Schema, "environment", "environment_slug"),
+	)
+	return resource
+}
+
+// clusterInheritedAttributes are the cluster attributes that fall back to the defaults of the environment.
+var clusterInheritedAttributes = []string{"update_channel", "maintenance_schedule_id", "pod_security_standards_profile", "delete_protection"}
+
+// setClusterEnvironmentDefaults sets the cluster attributes that are not configured to the defaults of the environment.
+// The defaults are only inherited on creation, and looked up on apply, so defaults changed in the same apply are used.
+func setClusterEnvironmentDefaults(ctx context.Context, d *schema.ResourceData, client acloudapi.Client, org, env string) error {
+	var unset []string
+	for _, attribute := range clusterInheritedAttributes {
+		if !isAttributeConfigured(d, attribute) {
+			unset = append(unset, attribute)
+		}
+	}
+	if len(unset) == 0 {
+		return nil
+	}
+
+	environment, err := client.GetEnvironment(ctx, org, env)
+	if err != nil {
+		return fmt.Errorf("failed to get environment %q: %w", env, err)
+	}
+	if environment == nil {
+		return fmt.Errorf("environment %q was not found in organisation %q", env, org)
+	}
+
+	defaults := clusterEnvironmentDefaults(*environment)
+	for _, attribute := range unset {
+		if value, ok := defaults[attribute]; ok {
+			d.Set(attribute, value)
+		}
+	}
+	return nil
+}
+
+// clusterEnvironmentDefaults returns the values clusters within the environment inherit. Attributes
+// without a default are left out, so the API decides their value.
+func clusterEnvironmentDefaults(environment acloudapi.Environment) map[string]interface{} {
+	defaults := map[string]interface{}{
+		"pod_security_standards_profile": "PRIVILEGED",
+		"delete_protection":              environment.DefaultDeleteProtection,
+	}
+	if environment.DefaultUpdateChannel != "" {
+		defaults["update_channel"] = environment.DefaultUpdateChannel
+	}
+	if environment.DefaultMaintenanceScheduleIdentity != "" {
+		defaults["maintenance_schedule_id"] = environment.DefaultMaintenanceScheduleIdentity
+	}
+	if environment.DefaultPodSecurityStandardsProfile != "" {
+		defaults["pod_security_standards_profile"] = environment.DefaultPodSecurityStandardsProfile
+	}
+	return defaults
+}
+
+// isAttributeConfigured reports whether an attribute is set in the configuration, as opposed to
+// holding a computed value.
+func isAttributeConfigured(d *schema.ResourceData, attribute string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		_, ok := d.GetOk(attribute)
+		return ok
+	}
+	return !config.GetAttr(attribute).IsNull()
 }
 
 func customizeClusterAddonsDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 	rawAddons, ok := d.GetOk("addons")
-	if !ok {
+	followMaintenanceSchedule := d.Get("kured_follow_maintenance_schedule").(bool)
+	if !ok && !followMaintenanceSchedule {
 		return nil
 	}
 
-	merged := mergeClusterAddons(defaultClusterAddons(), expandClusterAddons(rawAddons.(*schema.Set).List()))
+	var overrides map[string]acloudapi.APIAddon
+	if ok {
+		overrides = expandClusterAddons(rawAddons.(*schema.Set).List())
+	}
+	merged := mergeClusterAddons(defaultClusterAddons(), overrides)
+
+	if !followMaintenanceSchedule {
+		if err := customizeKuredRebootWindowDiff(ctx, d, m, merged); err != nil {
+			return err
+		}
+	} else {
+		if err := d.SetNew("kured_reboot_window_outside_schedule", false); err != nil {
+			return err
+		}
+		if !d.NewValueKnown("maintenance_schedule_id") {
+			return d.SetNewComputed("addons")
+		}
+		provider := getProvider(m)
+		org, err := getOrganisation(provider, d)
+		if err != nil {
+			return err
+		}
+		merged, err = applyKuredMaintenanceSchedule(ctx, provider.Client, org, d.Get("maintenance_schedule_id").(string), merged)
+		if err != nil {
+			return err
+		}
+	}
+
 	return d.SetNew("addons", flattenClusterAddons(merged))
 }
 
+// customizeKuredRebootWindowDiff plans kured_reboot_window_outside_schedule from the configured add-ons.
+// CustomizeDiff cannot return warnings, so the attribute is how the plan shows a reboot window outside the schedule.
+func customizeKuredRebootWindowDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}, addons map[string]acloudapi.APIAddon) error {
+	if !d.NewValueKnown("maintenance_schedule_id") {
+		return d.SetNewComputed("kured_reboot_window_outside_schedule")
+	}
+
+	var maintenanceWindows []acloudapi.MaintenanceWindow
+	if maintenanceScheduleID := d.Get("maintenance_schedule_id").(string); maintenanceScheduleID != "" {
+		provider := getProvider(m)
+		org, err := getOrganisation(provider, d)
+		if err != nil {
+			return err
+		}
+		maintenanceSchedule, err := provider.Client.GetMaintenanceSchedule(ctx, org, maintenanceScheduleID)
+		if err != nil || maintenanceSchedule == nil {
+			// the comparison is informational, so a failed lookup does not fail the plan
+			return d.SetNewComputed("kured_reboot_window_outside_schedule")
+		}
+		maintenanceWindows = maintenanceSchedule.MaintenanceWindows
+	}
+
+	outside, err := kuredOutsideMaintenanceSchedule(addons, maintenanceWindows)
+	if err != nil {
+		return d.SetNewComputed("kured_reboot_window_outside_schedule")
+	}
+	return d.SetNew("kured_reboot_window_outside_schedule", outside)
+}
+
+// applyKuredMaintenanceSchedule sets the kured reboot window of the add-ons to the windows of the maintenance schedule.
+func applyKuredMaintenanceSchedule(ctx context.Context, client acloudapi.Client, org, maintenanceScheduleID string, addons map[string]acloudapi.APIAddon) (map[string]acloudapi.APIAddon, error) {
+	if maintenanceScheduleID == "" {
+		return nil, errors.New("kured_follow_maintenance_schedule requires maintenance_schedule_id to be set")
+	}
+	maintenanceSchedule, err := client.GetMaintenanceSchedule(ctx, org, maintenanceScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance schedule %q: %w", maintenanceScheduleID, err)
+	}
+	if maintenanceSchedule == nil {
+		return nil, fmt.Errorf("maintenance schedule %q was not found", maintenanceScheduleID)
+	}
+	addons, err = applyKuredRebootWindow(addons, maintenanceSchedule.MaintenanceWindows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive kured reboot window from maintenance schedule %q: %w", maintenanceScheduleID, err)
+	}
+	return addons, nil
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	provider := getProvider(m)
 	client := provider.Client
@@ -219,6 +384,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setClusterEnvironmentDefaults(ctx, d, client, org, env); err != nil {
+		return diag.FromErr(err)
+	}
+
 	createCluster := acloudapi.CreateCluster{
 		Name:                         d.Get("name").(string),
 		Description:                  d.Get("description").(string),
@@ -241,12 +414,16 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if rawAddons, ok := getClusterAddonsInput(d); ok {
 		addons = mergeClusterAddons(addons, expandClusterAddons(rawAddons))
 	}
+	if d.Get("kured_follow_maintenance_schedule").(bool) {
+		addons, err = applyKuredMaintenanceSchedule(ctx, client, org, createCluster.MaintenanceScheduleIdentity, addons)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if len(addons) > 0 {
 		createCluster.Addons = addons
 	}
 
-	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
-
 	cluster, err := client.CreateCluster(ctx, org, env, createCluster)
 
 	if err != nil {
@@ -261,13 +438,27 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error while waiting for cluster: %w", err))
 		}
+		// delete protection cannot be set on creation
+		if d.Get("delete_protection").(bool) {
+			_, err = client.UpdateCluster(ctx, org, env, cluster.Slug, acloudapi.UpdateCluster{
+				DeleteProtection: ToPtr(true),
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to enable delete protection: %w", err))
+			}
+		}
 		return nil
 	}
 
 	return resourceClusterRead(ctx, d, m)
 }
 
-func getStringAttributeWithLegacyName(d *schema.ResourceData, names ...string) string {
+// attributeGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type attributeGetter interface {
+	Get(key string) interface{}
+}
+
+func getStringAttributeWithLegacyName(d attributeGetter, names ...string) string {
 	defaultValue := ""
 	for _, attributeName := range names {
 		value := d.Get(attributeName)
@@ -294,15 +485,26 @@ func setClusterAddonsState(d *schema.ResourceData, addons map[string]acloudapi.A
 	d.Set("addons", flattened)
 }
 
-func getOrganisation(provider ConfiguredProvider, d *schema.ResourceData) (string, error) {
+func getOrganisation(provider ConfiguredProvider, d attributeGetter) (string, error) {
 	organisation := getStringAttributeWithLegacyName(d, "organisation", "organisation_slug")
-	if organisation != "" {
-		return organisation, nil
+	if organisation == "" {
+		organisation = provider.Organisation
+	}
+	if organisation == "" {
+		return "", errors.New("organisation is not set")
 	}
-	if provider.Organisation != "" {
-		return provider.Organisation, nil
+	if err := checkAllowedOrganisation(provider, organisation); err != nil {
+		return "", err
+	}
+	return organisation, nil
+}
+
+func getEnvironment(provider ConfiguredProvider, d attributeGetter) (string, error) {
+	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
+	if err := checkAllowedEnvironment(provider, env); err != nil {
+		return "", err
 	}
-	return "", errors.New("organisation is not set")
+	return env, nil
 }
 
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -313,7 +515,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	slug := d.Get("slug").(string)
 
@@ -340,6 +545,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 	d.Set("enable_private_cluster", cluster.EnableNATGateway)
 	d.Set("enable_network_encryption", cluster.EnableNetworkEncryption)
 	d.Set("enable_auto_upgrade", cluster.AutoUpgrade)
+	d.Set("delete_protection", cluster.DeleteProtection)
 	d.Set("status", cluster.Status)
 	if cluster.MaintenanceSchedule != nil {
 		d.Set("maintenance_schedule_id", cluster.MaintenanceSchedule.Identity)
@@ -348,9 +554,87 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 	setClusterAddonsState(d, cluster.Addons)
 
+	maintenanceWindows, diags := setClusterNextMaintenanceWindows(ctx, d, client, org, cluster)
+	if diags == nil {
+		diags = setKuredRebootWindowState(d, cluster, maintenanceWindows)
+	}
+	return append(diags, setClusterMaintenanceFreezeActive(ctx, d, client, org, cluster)...)
+}
+
+// customizeClusterMaintenanceWindowsDiff marks the upcoming maintenance windows as changing with the maintenance schedule.
+func customizeClusterMaintenanceWindowsDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.HasChange("maintenance_schedule_id") {
+		return d.SetNewComputed("next_maintenance_windows")
+	}
 	return nil
 }
 
+// setClusterNextMaintenanceWindows sets the upcoming maintenance windows of the cluster and returns the UTC windows
+// of its maintenance schedule. The lookup is best-effort: a failure is returned as a warning and leaves
+// next_maintenance_windows unchanged, so the cluster itself stays readable.
+func setClusterNextMaintenanceWindows(ctx context.Context, d *schema.ResourceData, client acloudapi.Client, org string, cluster *acloudapi.Cluster) ([]acloudapi.MaintenanceWindow, diag.Diagnostics) {
+	maintenanceWindows, err := getClusterMaintenanceWindows(ctx, client, org, cluster)
+	if err == nil {
+		err = setNextMaintenanceWindows(d, "next_maintenance_windows", maintenanceWindows, defaultNextMaintenanceWindowsCount, "UTC")
+	}
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Failed to determine the upcoming maintenance windows of the cluster",
+			Detail:   fmt.Sprintf("next_maintenance_windows of cluster %q was not updated: %s", cluster.Slug, err),
+		}}
+	}
+	return maintenanceWindows, nil
+}
+
+// setKuredRebootWindowState sets kured_reboot_window_outside_schedule, and warns when a customised kured reboot window
+// falls outside the maintenance schedule.
+func setKuredRebootWindowState(d *schema.ResourceData, cluster *acloudapi.Cluster, maintenanceWindows []acloudapi.MaintenanceWindow) diag.Diagnostics {
+	if d.Get("kured_follow_maintenance_schedule").(bool) {
+		d.Set("kured_reboot_window_outside_schedule", false)
+		return nil
+	}
+
+	outside, err := kuredOutsideMaintenanceSchedule(cluster.Addons, maintenanceWindows)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Cannot compare kured reboot window with the maintenance schedule",
+			Detail:   err.Error(),
+		}}
+	}
+	d.Set("kured_reboot_window_outside_schedule", outside)
+	if !outside {
+		return nil
+	}
+	kured := cluster.Addons[kuredAddonName]
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Kured reboot window falls outside the maintenance schedule",
+		Detail: fmt.Sprintf("Nodes of cluster %q reboot between %s and %s (%s) on %s, which is not covered by maintenance schedule %q. Set kured_follow_maintenance_schedule to derive the reboot window from the schedule.",
+			cluster.Slug, kured.CustomValues["startTime"], kured.CustomValues["endTime"], kured.CustomValues["timeZone"], kured.CustomValues["rebootDays"], cluster.MaintenanceSchedule.Identity),
+	}}
+}
+
+// getClusterMaintenanceWindows returns the UTC windows of the cluster's maintenance schedule, if any.
+func getClusterMaintenanceWindows(ctx context.Context, client acloudapi.Client, org string, cluster *acloudapi.Cluster) ([]acloudapi.MaintenanceWindow, error) {
+	if cluster.MaintenanceSchedule == nil {
+		return nil, nil
+	}
+	if len(cluster.MaintenanceSchedule.MaintenanceWindows) > 0 {
+		return cluster.MaintenanceSchedule.MaintenanceWindows, nil
+	}
+
+	maintenanceSchedule, err := client.GetMaintenanceSchedule(ctx, org, cluster.MaintenanceSchedule.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance schedule %q: %w", cluster.MaintenanceSchedule.Identity, err)
+	}
+	if maintenanceSchedule == nil {
+		return nil, nil
+	}
+	return maintenanceSchedule.MaintenanceWindows, nil
+}
+
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	provider := getProvider(m)
 	client := provider.Client
@@ -364,7 +648,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return diags
 	}
 
-	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	slug := d.Get("slug").(string)
 
 	stopped := d.Get("stopped").(bool)
@@ -412,6 +699,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		maintenanceScheduleIdentity = newVal.(string)
 	}
 
+	deleteProtection := d.Get("delete_protection").(bool)
+	if d.HasChange("delete_protection") {
+		_, newVal := d.GetChange("delete_protection")
+		deleteProtection = newVal.(bool)
+	}
+
 	updateCluster := acloudapi.UpdateCluster{
 		UpdateChannel:               &updateChannel,
 		Version:                     &version,
@@ -420,11 +713,23 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		EnableHighAvailability:      &enableHAControlPlane,
 		EnableAutoUpgrade:           &enableAutoUpgrade,
 		MaintenanceScheduleIdentity: &maintenanceScheduleIdentity,
+		DeleteProtection:            &deleteProtection,
 	}
 
 	if d.HasChange("addons") || d.HasChange("addon") {
 		updateCluster.Addons = desiredClusterAddonsFromChange(d)
 	}
+	if d.Get("kured_follow_maintenance_schedule").(bool) && d.HasChanges("addons", "addon", "maintenance_schedule_id", "kured_follow_maintenance_schedule") {
+		addons := updateCluster.Addons
+		if addons == nil {
+			_, newVal := d.GetChange("addons")
+			addons = desiredClusterAddonsFromValue(newVal)
+		}
+		updateCluster.Addons, err = applyKuredMaintenanceSchedule(ctx, client, org, maintenanceScheduleIdentity, addons)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	desiredStatus := "running"
 	if stopped {
@@ -456,9 +761,16 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	slug := d.Get("slug").(string)
 
+	if err := checkProductionDestroy(ctx, d, provider, org, env, fmt.Sprintf("cluster %q", slug)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	updateCluster := acloudapi.UpdateCluster{
 		Status: ToPtr("deleting"),
 	}
@@ -557,3 +869,26 @@ func eventually(ctx context.Context, f func(ctx context.Context) error, timeout
 		}
 	}
 }
+
+// errStillExists is wrapped by the functions passed to eventuallyDeleted while a resource has not been deleted yet.
+var errStillExists = errors.New("still exists")
+
+// eventuallyDeleted calls f until it succeeds, as long as it returns errStillExists. Unlike eventually, any other
+// error, such as a failed authorisation, is returned right away instead of being retried until the timeout.
+func eventuallyDeleted(ctx context.Context, f func(ctx context.Context) error, timeout time.Duration) error {
+	withTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-withTimeout.Done():
+			return withTimeout.Err()
+		case <-time.After(10 * time.Second):
+			err := f(withTimeout)
+			if errors.Is(err, errStillExists) {
+				continue
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	clusterAccessPrincipalTypes = []string{"user", "team"}
+	clusterAccessRoles          = []string{"cluster-admin", "read-only"}
+)
+
+func resourceClusterAccess() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Grant a user or team access to a cluster",
+		CreateContext: resourceClusterAccessCreate,
+		ReadContext:   resourceClusterAccessRead,
+		UpdateContext: resourceClusterAccessUpdate,
+		DeleteContext: resourceClusterAccessDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Slug of the Organisation. Can only be set on creation.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Slug of the Environment. Can only be set on creation.",
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Slug of the Cluster. Can only be set on creation.",
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(clusterAccessPrincipalTypes, false),
+				Description:  "Type of the principal that is granted access. Available options: user, team",
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Email address of the user, or slug of the team, that is granted access",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(clusterAccessRoles, false),
+				Description:  "Role of the principal within the cluster. Available options: cluster-admin, read-only",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterAccessImport,
+		},
+	}
+}
+
+// resourceClusterAccessImport imports cluster access by an ID of the form [organisation/]environment/cluster/identity.
+func resourceClusterAccessImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == 4 {
+		d.Set("organisation", parts[0])
+		parts = parts[1:]
+	}
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return nil, fmt.Errorf("invalid import ID %q, expected [organisation/]environment/cluster/identity", d.Id())
+	}
+	d.Set("environment", parts[0])
+	d.Set("cluster", parts[1])
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceClusterAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	cluster, err := getClusterForNodePool(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
+	}
+	if cluster == nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found"))
+	}
+
+	clusterAccess, err := client.CreateClusterAccess(ctx, *cluster, acloudapi.CreateClusterAccess{
+		PrincipalType: d.Get("principal_type").(string),
+		Principal:     d.Get("principal").(string),
+		Role:          d.Get("role").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to grant access to cluster %q: %w", cluster.Slug, err))
+	}
+
+	d.SetId(clusterAccess.Identity)
+	return resourceClusterAccessRead(ctx, d, m)
+}
+
+func resourceClusterAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	cluster, err := getClusterForNodePool(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
+	}
+	if cluster == nil {
+		d.SetId("")
+		return nil
+	}
+
+	clusterAccesses, err := client.GetClusterAccess(ctx, *cluster)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get access of cluster %q: %w", cluster.Slug, err))
+	}
+
+	idx := slices.IndexFunc(clusterAccesses, func(clusterAccess acloudapi.ClusterAccess) bool {
+		return clusterAccess.Identity == d.Id()
+	})
+	if idx == -1 {
+		return diag.FromErr(fmt.Errorf("cluster access was not found"))
+	}
+
+	clusterAccess := clusterAccesses[idx]
+	d.Set("principal_type", clusterAccess.PrincipalType)
+	d.Set("principal", clusterAccess.Principal)
+	d.Set("role", clusterAccess.Role)
+	return nil
+}
+
+func resourceClusterAccessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	cluster, err := getClusterForNodePool(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
+	}
+	if cluster == nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found"))
+	}
+
+	_, err = client.UpdateClusterAccess(ctx, *cluster, d.Id(), acloudapi.UpdateClusterAccess{
+		Role: d.Get("role").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update access to cluster %q: %w", cluster.Slug, err))
+	}
+
+	return resourceClusterAccessRead(ctx, d, m)
+}
+
+func resourceClusterAccessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+
+	cluster, err := getClusterForNodePool(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
+	}
+	if cluster == nil {
+		return diag.FromErr(fmt.Errorf("cluster was not found"))
+	}
+
+	err = client.DeleteClusterAccess(ctx, *cluster, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to revoke access to cluster %q: %w", cluster.Slug, err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -2,17 +2,26 @@ package acloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"golang.org/x/exp/slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var environmentTypes = []string{"production", "staging", "development", "demo", "other"}
+
 func resourceEnvironment() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description:   "Create an environment",
 		CreateContext: resourceEnvironmentCreate,
 		ReadContext:   resourceEnvironmentRead,
@@ -59,11 +68,53 @@ func resourceEnvironment() *schema.Resource {
 				Optional:    true,
 				Description: "A human readable description about the environment",
 			},
+
+			// defaults inherited by clusters created within the environment, existing clusters keep their settings
+			"default_update_channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Update Channel for new clusters within the environment that do not set update_channel",
+			},
+			"default_maintenance_schedule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the maintenance schedule for new clusters within the environment that do not set maintenance_schedule_id",
+			},
+			"default_pod_security_standards_profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Pod Security Standards profile for new clusters within the environment that do not set pod_security_standards_profile",
+			},
+			"default_delete_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable delete protection for new clusters within the environment that do not set delete_protection",
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete all clusters and node pools within the environment when the environment is destroyed. Clusters with delete protection enabled are never deleted.",
+			},
+			"force_destroy_wait_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time-out for waiting until the node pools, and then the cluster itself, have been deleted when force_destroy is enabled. Clusters are deleted in parallel, and the delete timeout of the resource limits the total time.",
+			},
+			"allow_production_destroy": allowProductionDestroySchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
+	resource.CustomizeDiff = customizeProductionReplacementDiff(resource.Schema, "slug")
+	return resource
 }
 
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -74,11 +125,22 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	// the API generates the slug from the name, so the allow-list is checked against the expected slug
+	// before the environment is created
+	name := d.Get("name").(string)
+	if err := checkAllowedEnvironment(provider, environmentSlugFromName(name)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	createEnvironment := acloudapi.CreateEnvironment{
-		Name:        d.Get("name").(string),
-		Purpose:     d.Get("purpose").(string),
-		Type:        d.Get("type").(string),
-		Description: d.Get("description").(string),
+		Name:                               name,
+		Purpose:                            d.Get("purpose").(string),
+		Type:                               d.Get("type").(string),
+		Description:                        d.Get("description").(string),
+		DefaultUpdateChannel:               d.Get("default_update_channel").(string),
+		DefaultMaintenanceScheduleIdentity: d.Get("default_maintenance_schedule_id").(string),
+		DefaultPodSecurityStandardsProfile: d.Get("default_pod_security_standards_profile").(string),
+		DefaultDeleteProtection:            d.Get("default_delete_protection").(bool),
 	}
 
 	environment, err := client.CreateEnvironment(ctx, createEnvironment, org)
@@ -94,6 +156,13 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 	return resourceEnvironmentRead(ctx, d, m)
 }
 
+var environmentSlugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// environmentSlugFromName returns the slug the API generates for an environment with the given name.
+func environmentSlugFromName(name string) string {
+	return strings.Trim(environmentSlugSeparators.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
 func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	provider := getProvider(m)
 	client := provider.Client
@@ -103,6 +172,9 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	slug := d.Get("slug").(string)
+	if err := checkAllowedEnvironment(provider, slug); err != nil {
+		return diag.FromErr(err)
+	}
 	environment, err := client.GetEnvironment(ctx, org, slug)
 	if err != nil {
 		return diag.FromErr(err)
@@ -117,6 +189,10 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 	d.Set("purpose", environment.Purpose)
 	d.Set("type", environment.Type)
 	d.Set("description", environment.Description)
+	d.Set("default_update_channel", environment.DefaultUpdateChannel)
+	d.Set("default_maintenance_schedule_id", environment.DefaultMaintenanceScheduleIdentity)
+	d.Set("default_pod_security_standards_profile", environment.DefaultPodSecurityStandardsProfile)
+	d.Set("default_delete_protection", environment.DefaultDeleteProtection)
 
 	return nil
 }
@@ -130,13 +206,20 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	updateEnvironment := acloudapi.UpdateEnvironment{
-		Name:        d.Get("name").(string),
-		Purpose:     d.Get("purpose").(string),
-		Type:        d.Get("type").(string),
-		Description: d.Get("description").(string),
+		Name:                               d.Get("name").(string),
+		Purpose:                            d.Get("purpose").(string),
+		Type:                               d.Get("type").(string),
+		Description:                        d.Get("description").(string),
+		DefaultUpdateChannel:               d.Get("default_update_channel").(string),
+		DefaultMaintenanceScheduleIdentity: d.Get("default_maintenance_schedule_id").(string),
+		DefaultPodSecurityStandardsProfile: d.Get("default_pod_security_standards_profile").(string),
+		DefaultDeleteProtection:            d.Get("default_delete_protection").(bool),
 	}
 
 	env := d.Get("slug").(string)
+	if err := checkAllowedEnvironment(provider, env); err != nil {
+		return diag.FromErr(err)
+	}
 
 	environment, err := client.UpdateEnvironment(ctx, updateEnvironment, org, env)
 	if err != nil {
@@ -148,6 +231,10 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 		d.Set("type", environment.Type)
 		d.Set("description", environment.Description)
 		d.Set("slug", environment.Slug)
+		d.Set("default_update_channel", environment.DefaultUpdateChannel)
+		d.Set("default_maintenance_schedule_id", environment.DefaultMaintenanceScheduleIdentity)
+		d.Set("default_pod_security_standards_profile", environment.DefaultPodSecurityStandardsProfile)
+		d.Set("default_delete_protection", environment.DefaultDeleteProtection)
 		return nil
 	}
 
@@ -163,6 +250,20 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	slug := d.Get("slug").(string)
+	if err := checkAllowedEnvironment(provider, slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkProductionDestroy(ctx, d, provider, org, slug, fmt.Sprintf("environment %q", slug)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("force_destroy").(bool) {
+		timeout := time.Duration(d.Get("force_destroy_wait_seconds").(int)) * time.Second
+		if err := deleteEnvironmentClusters(ctx, client, org, slug, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	error := client.DeleteEnvironment(ctx, org, slug)
 	if error != nil {
@@ -173,3 +274,98 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 
 	return nil
 }
+
+// deleteEnvironmentClusters deletes the node pools and clusters within an environment, waiting for each
+// to be deleted. Nothing is deleted when any of the clusters has delete protection enabled.
+func deleteEnvironmentClusters(ctx context.Context, client acloudapi.Client, org, env string, timeout time.Duration) error {
+	clusters, err := client.GetClusters(ctx, acloudapi.ListClusterOpts{
+		OrganisationSlug: org,
+		EnvironmentSlug:  env,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get clusters for environment %q: %w", env, err)
+	}
+
+	var protected []string
+	for _, cluster := range clusters {
+		if cluster.DeleteProtection {
+			protected = append(protected, cluster.Slug)
+		}
+	}
+	if len(protected) > 0 {
+		return fmt.Errorf("cannot force destroy environment %q, delete protection is enabled on clusters %s", env, strings.Join(protected, ", "))
+	}
+
+	errs := make([]error, len(clusters))
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = deleteEnvironmentCluster(ctx, client, org, env, cluster, timeout)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func deleteEnvironmentCluster(ctx context.Context, client acloudapi.Client, org, env string, cluster acloudapi.Cluster, timeout time.Duration) error {
+	if err := deleteClusterNodePools(ctx, client, cluster, timeout); err != nil {
+		return err
+	}
+
+	err := client.DeleteCluster(ctx, org, env, cluster.Slug, acloudapi.UpdateCluster{
+		Status: ToPtr("deleting"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete cluster %q: %w", cluster.Slug, err)
+	}
+	err = eventuallyDeleted(ctx, func(ctx context.Context) error {
+		remaining, err := client.GetClusters(ctx, acloudapi.ListClusterOpts{
+			OrganisationSlug: org,
+			EnvironmentSlug:  env,
+		})
+		if err != nil {
+			return err
+		}
+		if slices.ContainsFunc(remaining, func(c acloudapi.Cluster) bool { return c.Identity == cluster.Identity }) {
+			return fmt.Errorf("cluster %q %w", cluster.Slug, errStillExists)
+		}
+		return nil
+	}, timeout)
+	if err != nil {
+		return fmt.Errorf("error while waiting for cluster %q to be deleted: %w", cluster.Slug, err)
+	}
+	return nil
+}
+
+func deleteClusterNodePools(ctx context.Context, client acloudapi.Client, cluster acloudapi.Cluster, timeout time.Duration) error {
+	nodePools, err := client.GetNodePoolsByCluster(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get node pools of cluster %q: %w", cluster.Slug, err)
+	}
+	if len(nodePools) == 0 {
+		return nil
+	}
+
+	for _, nodePool := range nodePools {
+		if err := client.DeleteNodePool(ctx, cluster, nodePool.ID); err != nil {
+			return fmt.Errorf("failed to delete node pool %q of cluster %q: %w", nodePool.Name, cluster.Slug, err)
+		}
+	}
+
+	err = eventuallyDeleted(ctx, func(ctx context.Context) error {
+		remaining, err := client.GetNodePoolsByCluster(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 {
+			return fmt.Errorf("cluster %q still has %d node pools: %w", cluster.Slug, len(remaining), errStillExists)
+		}
+		return nil
+	}, timeout)
+	if err != nil {
+		return fmt.Errorf("error while waiting for the node pools of cluster %q to be deleted: %w", cluster.Slug, err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+func resourceMaintenanceFreeze() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create a maintenance freeze, pausing auto-upgrades and platform maintenance for clusters during a period of time",
+		CreateContext: resourceMaintenanceFreezeCreate,
+		ReadContext:   resourceMaintenanceFreezeRead,
+		UpdateContext: resourceMaintenanceFreezeUpdate,
+		DeleteContext: resourceMaintenanceFreezeDelete,
+		CustomizeDiff: customizeMaintenanceFreezeDiff,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Slug of the Organisation. Can only be set on creation.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Start of the maintenance freeze as an RFC 3339 timestamp, such as `2026-12-20T00:00:00+01:00`",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "End of the maintenance freeze as an RFC 3339 timestamp, such as `2027-01-04T00:00:00+01:00`",
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Reason for the maintenance freeze",
+			},
+			"cluster_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"cluster_ids", "maintenance_schedule_ids"},
+				Description:  "UUID Identities of the clusters the maintenance freeze applies to",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"maintenance_schedule_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"cluster_ids", "maintenance_schedule_ids"},
+				Description:  "IDs of the maintenance schedules the maintenance freeze applies to. Applies to every cluster using one of these schedules.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the maintenance freeze currently active",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func customizeMaintenanceFreezeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start_time") || !d.NewValueKnown("end_time") {
+		return nil
+	}
+	startTime, endTime, err := getMaintenanceFreezePeriod(d)
+	if err != nil {
+		return err
+	}
+	if !endTime.After(startTime) {
+		return fmt.Errorf("end_time %s must be after start_time %s", endTime.Format(time.RFC3339), startTime.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func getMaintenanceFreezePeriod(d attributeGetter) (time.Time, time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start_time: %w", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end_time: %w", err)
+	}
+	return startTime, endTime, nil
+}
+
+func expandMaintenanceFreeze(d *schema.ResourceData) (acloudapi.CreateMaintenanceFreeze, error) {
+	startTime, endTime, err := getMaintenanceFreezePeriod(d)
+	if err != nil {
+		return acloudapi.CreateMaintenanceFreeze{}, err
+	}
+	return acloudapi.CreateMaintenanceFreeze{
+		Reason:                        d.Get("reason").(string),
+		StartTime:                     startTime,
+		EndTime:                       endTime,
+		ClusterIdentities:             castStringSet(d.Get("cluster_ids").(*schema.Set)),
+		MaintenanceScheduleIdentities: castStringSet(d.Get("maintenance_schedule_ids").(*schema.Set)),
+	}, nil
+}
+
+func castStringSet(set *schema.Set) []string {
+	result := []string{}
+	for _, value := range set.List() {
+		result = append(result, value.(string))
+	}
+	return result
+}
+
+func resourceMaintenanceFreezeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createMaintenanceFreeze, err := expandMaintenanceFreeze(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maintenanceFreeze, err := client.CreateMaintenanceFreeze(ctx, org, createMaintenanceFreeze)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create maintenance freeze: %w", err))
+	}
+
+	d.SetId(maintenanceFreeze.Identity)
+	return resourceMaintenanceFreezeRead(ctx, d, m)
+}
+
+func resourceMaintenanceFreezeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maintenanceFreeze, err := client.GetMaintenanceFreeze(ctx, org, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get maintenance freeze: %w", err))
+	}
+	if maintenanceFreeze == nil {
+		return diag.FromErr(fmt.Errorf("maintenance freeze was not found"))
+	}
+
+	// keep the configured notation of the timestamps when they refer to the same instant
+	if startTime, endTime, err := getMaintenanceFreezePeriod(d); err != nil || !startTime.Equal(maintenanceFreeze.StartTime) || !endTime.Equal(maintenanceFreeze.EndTime) {
+		d.Set("start_time", maintenanceFreeze.StartTime.Format(time.RFC3339))
+		d.Set("end_time", maintenanceFreeze.EndTime.Format(time.RFC3339))
+	}
+	d.Set("reason", maintenanceFreeze.Reason)
+	d.Set("cluster_ids", maintenanceFreeze.ClusterIdentities)
+	d.Set("maintenance_schedule_ids", maintenanceFreeze.MaintenanceScheduleIdentities)
+	d.Set("active", isMaintenanceFreezeActive(*maintenanceFreeze, time.Now()))
+
+	return nil
+}
+
+func resourceMaintenanceFreezeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateMaintenanceFreeze, err := expandMaintenanceFreeze(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateMaintenanceFreeze(ctx, org, d.Id(), acloudapi.UpdateMaintenanceFreeze(updateMaintenanceFreeze))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update maintenance freeze: %w", err))
+	}
+
+	return resourceMaintenanceFreezeRead(ctx, d, m)
+}
+
+func resourceMaintenanceFreezeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteMaintenanceFreeze(ctx, org, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete maintenance freeze: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isMaintenanceFreezeActive(maintenanceFreeze acloudapi.MaintenanceFreeze, now time.Time) bool {
+	return !now.Before(maintenanceFreeze.StartTime) && now.Before(maintenanceFreeze.EndTime)
+}
+
+// setClusterMaintenanceFreezeActive sets maintenance_freeze_active of the cluster. The lookup is best-effort:
+// a failure is returned as a warning and leaves the attribute unchanged, so the cluster itself stays readable.
+func setClusterMaintenanceFreezeActive(ctx context.Context, d *schema.ResourceData, client acloudapi.Client, org string, cluster *acloudapi.Cluster) diag.Diagnostics {
+	maintenanceFrozen, err := isClusterMaintenanceFrozen(ctx, client, org, cluster)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Failed to determine whether a maintenance freeze is active for the cluster",
+			Detail:   fmt.Sprintf("maintenance_freeze_active of cluster %q was not updated: %s", cluster.Slug, err),
+		}}
+	}
+	d.Set("maintenance_freeze_active", maintenanceFrozen)
+	return nil
+}
+
+// isClusterMaintenanceFrozen reports whether a maintenance freeze applying to the cluster, directly or
+// through its maintenance schedule, is currently active.
+func isClusterMaintenanceFrozen(ctx context.Context, client acloudapi.Client, org string, cluster *acloudapi.Cluster) (bool, error) {
+	maintenanceFreezes, err := client.GetMaintenanceFreezes(ctx, org)
+	if err != nil {
+		return false, fmt.Errorf("failed to get maintenance freezes for organisation %q: %w", org, err)
+	}
+
+	now := time.Now()
+	for _, maintenanceFreeze := range maintenanceFreezes {
+		if !isMaintenanceFreezeActive(maintenanceFreeze, now) {
+			continue
+		}
+		if slices.Contains(maintenanceFreeze.ClusterIdentities, cluster.Identity) {
+			return true, nil
+		}
+		if cluster.MaintenanceSchedule != nil && slices.Contains(maintenanceFreeze.MaintenanceScheduleIdentities, cluster.MaintenanceSchedule.Identity) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -2,10 +2,13 @@ package acloud
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/avisi-cloud/go-client/pkg/acloudapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceMaintenanceSchedule() *schema.Resource {
@@ -15,6 +18,7 @@ func resourceMaintenanceSchedule() *schema.Resource {
 		ReadContext:   resourceMaintenanceScheduleRead,
 		UpdateContext: resourceMaintenanceScheduleUpdate,
 		DeleteContext: resourceMaintenanceScheduleDelete,
+		CustomizeDiff: customizeMaintenanceScheduleDiff,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -31,6 +35,13 @@ func resourceMaintenanceSchedule() *schema.Resource {
 				Required:    true,
 				Description: "Name of the maintenance schedule",
 			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+				Description:  "IANA time zone of the maintenance windows, such as `Europe/Amsterdam`. Windows are converted to UTC using the offset of the time zone at their next occurrence, so after a daylight saving change the next apply moves the windows in UTC to keep their local time.",
+			},
 			"windows": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -38,23 +49,34 @@ func resourceMaintenanceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"day": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Day of the maintenance window",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(maintenanceWindowDays, false),
+							Description:  "Day of the maintenance window. Available options: monday, tuesday, wednesday, thursday, friday, saturday, sunday",
 						},
 						"start_time": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Start time of the maintenance window",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(maintenanceWindowStartTimeRegexp, "must be a time in HH:MM format"),
+							Description:  "Start time of the maintenance window in HH:MM format",
 						},
 						"duration": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Duration in minutes of the maintenance window",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(minMaintenanceWindowDuration, maxMaintenanceWindowDuration),
+							Description:  "Duration in minutes of the maintenance window, between 30 and 1440",
 						},
 					},
 				},
 			},
+			"next_windows_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultNextMaintenanceWindowsCount,
+				ValidateFunc: validation.IntBetween(1, 52),
+				Description:  "Number of upcoming maintenance windows to return in next_windows",
+			},
+			"next_windows": nextMaintenanceWindowsSchema(),
 		},
 	}
 }
@@ -67,9 +89,14 @@ func resourceMaintenanceScheduleCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	windows, err := maintenanceWindowsToUTC(castMaintenanceWindows(d.Get("windows").([]interface{})), d.Get("time_zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createMaintenanceSchedule, err := client.CreateMaintenanceSchedule(ctx, org, acloudapi.CreateMaintenanceSchedule{
 		Name:    d.Get("name").(string),
-		Windows: castMaintenanceWindows(d.Get("windows").([]interface{})),
+		Windows: windows,
 	})
 
 	if err != nil {
@@ -93,6 +120,34 @@ func castMaintenanceWindows(windows []interface{}) []acloudapi.MaintenanceWindow
 	return maintenanceWindows
 }
 
+func customizeMaintenanceScheduleDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.HasChanges("windows", "time_zone", "next_windows_count") {
+		if err := d.SetNewComputed("next_windows"); err != nil {
+			return err
+		}
+	}
+	if !d.NewValueKnown("windows") {
+		return nil
+	}
+	windows := d.Get("windows").([]interface{})
+	for i := range windows {
+		// nested values can be unknown until apply, such as start times computed from other resources
+		for _, attr := range []string{"day", "start_time", "duration"} {
+			if !d.NewValueKnown(fmt.Sprintf("windows.%d.%s", i, attr)) {
+				return nil
+			}
+		}
+	}
+	return validateMaintenanceWindowsOverlap(castMaintenanceWindows(windows))
+}
+
+func validateTimeZone(v interface{}, k string) (warnings []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: invalid time zone: %w", k, err))
+	}
+	return warnings, errors
+}
+
 func resourceMaintenanceScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	provider := getProvider(m)
 	client := provider.Client
@@ -107,8 +162,17 @@ func resourceMaintenanceScheduleRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	if maintenanceSchedule != nil {
+		windows, err := maintenanceWindowsFromUTC(maintenanceSchedule.MaintenanceWindows, d.Get("time_zone").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		d.Set("name", maintenanceSchedule.Name)
-		d.Set("windows", maintenanceSchedule.MaintenanceWindows)
+		d.Set("windows", flattenMaintenanceWindows(windows))
+
+		err = setNextMaintenanceWindows(d, "next_windows", maintenanceSchedule.MaintenanceWindows, d.Get("next_windows_count").(int), d.Get("time_zone").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return nil
@@ -122,20 +186,20 @@ func resourceMaintenanceScheduleUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	updateMaintenanceSchedule, err := client.UpdateMaintenanceSchedule(ctx, org, d.Id(), acloudapi.UpdateMaintenanceSchedule{
+	windows, err := maintenanceWindowsToUTC(castMaintenanceWindows(d.Get("windows").([]interface{})), d.Get("time_zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateMaintenanceSchedule(ctx, org, d.Id(), acloudapi.UpdateMaintenanceSchedule{
 		Name:    d.Get("name").(string),
-		Windows: castMaintenanceWindows(d.Get("windows").([]interface{})),
+		Windows: windows,
 	})
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if updateMaintenanceSchedule != nil {
-		d.Set("name", updateMaintenanceSchedule.Name)
-		d.Set("windows", updateMaintenanceSchedule.MaintenanceWindows)
-	}
-
 	return resourceMaintenanceScheduleRead(ctx, d, m)
 }
 
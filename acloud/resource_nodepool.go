@@ -14,7 +14,7 @@ import (
 )
 
 func resourceNodepool() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Description:   "Create a node pool for a cluster",
 		CreateContext: resourceNodepoolCreate,
 		ReadContext:   resourceNodepoolRead,
@@ -160,11 +160,14 @@ func resourceNodepool() *schema.Resource {
 					},
 				},
 			},
+			"allow_production_destroy": allowProductionDestroySchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
+	resource.CustomizeDiff = customizeProductionReplacementDiff(resource.Schema, "environment", "environment_slug")
+	return resource
 }
 
 func resourceNodepoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -239,6 +242,39 @@ func castNodeTaints(taints []interface{}) []acloudapi.NodeTaint {
 	return result
 }
 
+func flattenNodeTaints(taints []acloudapi.NodeTaint) []interface{} {
+	result := make([]interface{}, len(taints))
+
+	for i, taint := range taints {
+		result[i] = map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": taint.Effect,
+		}
+	}
+
+	return result
+}
+
+func nodeTaintAttributesResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effect": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
 func castInterfaceMap(original map[string]interface{}) map[string]string {
 	result := make(map[string]string)
 
@@ -259,7 +295,10 @@ func getClusterForNodePool(ctx context.Context, d *schema.ResourceData, m interf
 		return nil, err
 	}
 
-	env := getStringAttributeWithLegacyName(d, "environment", "environment_slug")
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return nil, err
+	}
 	cls := getStringAttributeWithLegacyName(d, "cluster", "cluster_slug")
 
 	return client.GetCluster(ctx, org, env, cls)
@@ -312,7 +351,7 @@ func resourceNodepoolRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("max_size", nodePool.MaxSize)
 	d.Set("annotations", annotations)
 	d.Set("labels", labels)
-	d.Set("taints", nodePool.Taints)
+	d.Set("taints", flattenNodeTaints(nodePool.Taints))
 	return nil
 }
 
@@ -364,6 +403,18 @@ func resourceNodepoolDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(fmt.Errorf("cluster was not found: %w", err))
 	}
 
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	env, err := getEnvironment(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkProductionDestroy(ctx, d, provider, org, env, fmt.Sprintf("node pool %q of cluster %q", d.Get("name").(string), cluster.Slug)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	nodePoolID, _ := strconv.Atoi(d.Get("id").(string))
 
 	err = client.DeleteNodePool(ctx, *cluster, nodePoolID)
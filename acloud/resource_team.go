@@ -0,0 +1,169 @@
+package acloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avisi-cloud/go-client/pkg/acloudapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Create a team of users within an organisation",
+		CreateContext: resourceTeamCreate,
+		ReadContext:   resourceTeamRead,
+		UpdateContext: resourceTeamUpdate,
+		DeleteContext: resourceTeamDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Slug of the Organisation. Can only be set on creation.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Team",
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A human readable description about the team",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Email addresses of the members of the Team. Members must be part of the organisation.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	team, err := client.CreateTeam(ctx, org, acloudapi.CreateTeam{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Members:     castStringSet(d.Get("members").(*schema.Set)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create team: %w", err))
+	}
+
+	d.SetId(team.Identity)
+	d.Set("slug", team.Slug)
+	return resourceTeamRead(ctx, d, m)
+}
+
+func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	team, err := getTeam(ctx, client, org, d.Get("slug").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(team.Identity)
+	d.Set("name", team.Name)
+	d.Set("slug", team.Slug)
+	d.Set("description", team.Description)
+	d.Set("members", team.Members)
+
+	return nil
+}
+
+// getTeam looks up a team by slug, falling back to its identity for imported teams without a slug in state.
+func getTeam(ctx context.Context, client acloudapi.Client, org, slug, identity string) (*acloudapi.Team, error) {
+	if slug != "" {
+		team, err := client.GetTeam(ctx, org, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team %q in organisation %q: %w", slug, org, err)
+		}
+		if team == nil {
+			return nil, fmt.Errorf("team %q was not found in organisation %q", slug, org)
+		}
+		return team, nil
+	}
+
+	teams, err := client.GetTeams(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams for organisation %q: %w", org, err)
+	}
+	for _, team := range teams {
+		if team.Identity == identity {
+			return &team, nil
+		}
+	}
+	return nil, fmt.Errorf("team with identity %q was not found in organisation %q", identity, org)
+}
+
+func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	team, err := client.UpdateTeam(ctx, org, d.Get("slug").(string), acloudapi.UpdateTeam{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Members:     castStringSet(d.Get("members").(*schema.Set)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update team: %w", err))
+	}
+	if team != nil {
+		// renaming a team can change its slug
+		d.Set("slug", team.Slug)
+	}
+
+	return resourceTeamRead(ctx, d, m)
+}
+
+func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	provider := getProvider(m)
+	client := provider.Client
+	org, err := getOrganisation(provider, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteTeam(ctx, org, d.Get("slug").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete team: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}